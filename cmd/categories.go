@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/preflightsh/preflight/internal/checks"
+)
+
+var (
+	listCategoriesFlag bool
+	listJSONFlag       bool
+)
+
+func init() {
+	listChecksCmd.Flags().BoolVar(&listCategoriesFlag, "categories", false, "Group checks by category, with a count for each")
+	listChecksCmd.Flags().BoolVar(&listJSONFlag, "json", false, "Print the listing as JSON")
+}
+
+// checkListing is one check in the JSON listing
+type checkListing struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Category string `json:"category"`
+	Service  bool   `json:"service,omitempty"`
+}
+
+// categoryListing is one category in the JSON --categories listing
+type categoryListing struct {
+	Name   string         `json:"name"`
+	Count  int            `json:"count"`
+	Checks []checkListing `json:"checks"`
+}
+
+// registeredChecks lists every registered check in registry order
+func registeredChecks() []checkListing {
+	listing := make([]checkListing, 0, len(checks.Registry))
+	for _, check := range checks.Registry {
+		listing = append(listing, checkListing{
+			ID:       check.ID(),
+			Title:    check.Title(),
+			Category: checks.Category(check.ID()),
+			Service:  checks.IsService(check.ID()),
+		})
+	}
+	return listing
+}
+
+// groupByCategory groups checks by category, sorted by name, keeping
+// registry order within each category
+func groupByCategory(listing []checkListing) []categoryListing {
+	index := make(map[string]int)
+	var groups []categoryListing
+	for _, c := range listing {
+		i, ok := index[c.Category]
+		if !ok {
+			i = len(groups)
+			index[c.Category] = i
+			groups = append(groups, categoryListing{Name: c.Category})
+		}
+		groups[i].Checks = append(groups[i].Checks, c)
+		groups[i].Count++
+	}
+	sort.Slice(groups, func(a, b int) bool { return groups[a].Name < groups[b].Name })
+	return groups
+}
+
+// printCheckListing prints the registered checks for --categories and/or
+// --json. Without --categories the JSON is a flat list for scripting.
+func printCheckListing() error {
+	listing := registeredChecks()
+
+	if listJSONFlag {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if listCategoriesFlag {
+			return encoder.Encode(groupByCategory(listing))
+		}
+		return encoder.Encode(listing)
+	}
+
+	groups := groupByCategory(listing)
+	for i, group := range groups {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%s (%d)\n", group.Name, group.Count)
+		for _, c := range group.Checks {
+			suffix := ""
+			if c.Service {
+				suffix = " [service]"
+			}
+			fmt.Printf("  - %-22s %s%s\n", c.ID, c.Title, suffix)
+		}
+	}
+	fmt.Println()
+	fmt.Printf("%d checks in %d categories\n", len(listing), len(groups))
+	return nil
+}
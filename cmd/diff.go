@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/preflightsh/preflight/internal/output"
+	"github.com/spf13/cobra"
+)
+
+var diffFormat string
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <old.json> <new.json>",
+	Short: "Compare two saved JSON scan results",
+	Long: `Compare two reports saved with 'preflight scan --format json' and list
+checks that newly failed, were fixed, or changed severity. Exits with code 1
+if anything newly failed, so CI can gate on "no new failures":
+
+  preflight scan --ci --format json > new.json
+  preflight diff main.json new.json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDiff,
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+	diffCmd.Flags().StringVar(&diffFormat, "format", "text", "Output format: text or json")
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	format := strings.ToLower(diffFormat)
+	if format == "human" {
+		format = "text"
+	}
+	if format != "text" && format != "json" {
+		return fmt.Errorf("unknown format %q (use text or json)", diffFormat)
+	}
+
+	older, err := output.ReadJSONOutput(args[0])
+	if err != nil {
+		return err
+	}
+	newer, err := output.ReadJSONOutput(args[1])
+	if err != nil {
+		return err
+	}
+
+	diff := output.DiffReports(older, newer)
+	if format == "json" {
+		if err := output.PrintDiffJSON(os.Stdout, diff); err != nil {
+			return err
+		}
+	} else {
+		output.PrintDiffText(os.Stdout, diff, !useColor())
+	}
+
+	if len(diff.NewFailures) > 0 {
+		os.Exit(1)
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/preflightsh/preflight/internal/checks"
+	"github.com/preflightsh/preflight/internal/config"
+	"github.com/preflightsh/preflight/internal/stack"
+	"github.com/spf13/cobra"
+)
+
+var doctorConfigFlag string
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor [path]",
+	Short: "Validate your config and environment without running checks",
+	Long: `Validate preflight.yml and the environment it describes without running
+any checks. Reports unknown keys, invalid stack values, malformed or
+unreachable URLs, ignore entries that match no check, and missing layout
+or env files. Exits with code 1 if the config has errors.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runDoctor,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+	doctorCmd.Flags().StringVar(&doctorConfigFlag, "config", "", "Path to preflight.yml (skips searching parent directories); - reads it from stdin")
+}
+
+// diagnostics collects doctor findings and prints them as they are added
+type diagnostics struct {
+	errors   int
+	warnings int
+}
+
+func (d *diagnostics) ok(format string, args ...interface{}) {
+	fmt.Printf("  ✓ %s\n", fmt.Sprintf(format, args...))
+}
+
+func (d *diagnostics) warn(format string, args ...interface{}) {
+	d.warnings++
+	fmt.Printf("  ⚠ %s\n", fmt.Sprintf(format, args...))
+}
+
+func (d *diagnostics) fail(format string, args ...interface{}) {
+	d.errors++
+	fmt.Printf("  ✗ %s\n", fmt.Sprintf(format, args...))
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	startDir := "."
+	if len(args) > 0 {
+		startDir = args[0]
+	}
+
+	fmt.Println()
+	fmt.Println(" 🩺 Preflight Doctor")
+	fmt.Println()
+
+	d := &diagnostics{}
+
+	cfgFile := doctorConfigFlag
+	if cfgFile == "" {
+		found, err := config.Find(startDir)
+		if err != nil {
+			d.fail("%v", err)
+			fmt.Println("    Run 'preflight init' to create a configuration file.")
+			return finishDoctor(d)
+		}
+		cfgFile = found
+	}
+	projectDir := filepath.Dir(cfgFile)
+	if cfgFile == config.StdinPath {
+		d.ok("Config file: read from stdin")
+		projectDir = startDir
+	} else {
+		d.ok("Config file: %s", cfgFile)
+	}
+	if len(args) > 0 {
+		projectDir = args[0]
+	}
+
+	// Unknown keys are usually typos that silently leave a setting unset
+	unknown, err := config.UnknownKeys(cfgFile)
+	if err != nil {
+		d.fail("%v", err)
+		return finishDoctor(d)
+	}
+	for _, key := range unknown {
+		msg := fmt.Sprintf("Unknown key %q (line %d)", key.Path, key.Line)
+		if key.Suggestion != "" {
+			msg += fmt.Sprintf(", did you mean %q?", key.Suggestion)
+		}
+		d.fail("%s", msg)
+	}
+
+	// Unknown keys were reported above, so load leniently to keep diagnosing
+	cfg, err := config.LoadFileLenient(cfgFile)
+	if err != nil {
+		d.fail("%v", err)
+		return finishDoctor(d)
+	}
+
+	if cfg.ProjectName == "" {
+		d.warn("projectName is empty")
+	}
+
+	// Monorepo scoping; later lookups happen inside the resolved paths
+	stackDir := projectDir
+	if len(cfg.Paths) > 0 {
+		paths, err := resolvePaths(projectDir, cfg.Paths)
+		if err != nil {
+			d.fail("%v", err)
+			cfg.Paths = nil
+		} else {
+			d.ok("File checks scoped to: %s", strings.Join(paths, ", "))
+			cfg.Paths = paths
+			stackDir = filepath.Join(projectDir, paths[0])
+		}
+	}
+	checks.NewFileIndex(projectDir, cfg.Paths...)
+
+	// Stack
+	switch {
+	case cfg.Stack == "unknown":
+		detected := stack.DetectStack(stackDir)
+		if detected == "unknown" {
+			d.warn("stack is not set and could not be detected; stack-specific checks will use generic paths")
+		} else {
+			d.ok("stack not set; detected %s", detected)
+		}
+	case !contains(stack.Supported, cfg.Stack):
+		msg := fmt.Sprintf("Unknown stack %q", cfg.Stack)
+		if s := config.Suggest(cfg.Stack, stack.Supported); s != "" {
+			msg += fmt.Sprintf(", did you mean %q?", s)
+		}
+		d.fail("%s", msg)
+	default:
+		d.ok("stack: %s", cfg.Stack)
+	}
+	if cfg.Stack == "unknown" {
+		cfg.Stack = stack.DetectStack(stackDir)
+	}
+
+	// URLs
+	if err := checks.SetProxy(cfg.HTTP.Proxy); err != nil {
+		d.fail("%v", err)
+	} else if cfg.HTTP.Proxy != "" {
+		d.ok("http.proxy: %s", cfg.HTTP.Proxy)
+	}
+	client := checks.WithUserAgent(checks.NewHTTPClient(5*time.Second), cfg.HTTP.UserAgent)
+	urls := []struct{ key, value string }{
+		{"urls.staging", cfg.URLs.Staging},
+		{"urls.production", cfg.URLs.Production},
+	}
+	if cfg.Checks.StripeWebhook != nil && cfg.Checks.StripeWebhook.Enabled {
+		urls = append(urls, struct{ key, value string }{"checks.stripeWebhook.url", cfg.Checks.StripeWebhook.URL})
+	}
+	if cfg.URLs.Staging == "" && cfg.URLs.Production == "" {
+		d.warn("No staging or production URL configured; live checks (headers, SSL, robots.txt, ...) will be skipped")
+	}
+	for _, u := range urls {
+		if u.value == "" {
+			continue
+		}
+		parsed, err := url.Parse(u.value)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			d.fail("%s is not an absolute http(s) URL: %q", u.key, u.value)
+			continue
+		}
+		req, err := http.NewRequest("GET", u.value, nil)
+		if err != nil {
+			d.fail("%s is not a valid URL: %v", u.key, err)
+			continue
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			d.warn("%s is unreachable: %v", u.key, err)
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 500 {
+			d.warn("%s returned HTTP %d", u.key, resp.StatusCode)
+		} else {
+			d.ok("%s reachable (HTTP %d)", u.key, resp.StatusCode)
+		}
+	}
+
+	// Ignore list
+	checkIDs := checks.IDs()
+	seen := make(map[string]bool)
+	for _, id := range cfg.Ignore {
+		if seen[id] {
+			d.warn("ignore lists %q more than once", id)
+			continue
+		}
+		seen[id] = true
+		if !contains(checkIDs, id) {
+			msg := fmt.Sprintf("ignore entry %q matches no check or service", id)
+			if s := config.Suggest(id, checkIDs); s != "" {
+				msg += fmt.Sprintf(", did you mean %q?", s)
+			}
+			d.warn("%s", msg)
+		}
+	}
+
+	// Layout used by the SEO checks
+	if cfg.Checks.SEOMeta != nil && cfg.Checks.SEOMeta.MainLayout != "" {
+		layout := cfg.Checks.SEOMeta.MainLayout
+		if _, err := os.Stat(filepath.Join(projectDir, layout)); err != nil {
+			d.fail("checks.seoMeta.mainLayout %q not found in %s", layout, projectDir)
+		} else {
+			d.ok("Main layout: %s", layout)
+		}
+	} else if layout := checks.DetectLayout(projectDir, cfg.Stack); layout != "" {
+		d.ok("Main layout: %s (auto-detected)", layout)
+	} else {
+		d.warn("No main layout found for stack %q; SEO checks will be skipped. Set checks.seoMeta.mainLayout", cfg.Stack)
+	}
+
+	// Per-check settings
+	if ep := cfg.Checks.EnvParity; ep != nil && ep.Enabled {
+		for _, file := range []string{ep.EnvFile, ep.ExampleFile} {
+			if _, err := os.Stat(filepath.Join(projectDir, file)); err != nil {
+				d.warn("checks.envParity: %s not found", file)
+			}
+		}
+	}
+	if he := cfg.Checks.HealthEndpoint; he != nil && he.Path != "" && !strings.HasPrefix(he.Path, "/") {
+		d.fail("checks.healthEndpoint.path must start with /: %q", he.Path)
+	}
+	if in := cfg.Checks.IndexNow; in != nil && in.Enabled && in.Key == "" {
+		d.warn("checks.indexNow is enabled but has no key")
+	}
+
+	return finishDoctor(d)
+}
+
+func finishDoctor(d *diagnostics) error {
+	fmt.Println()
+	if d.errors == 0 && d.warnings == 0 {
+		fmt.Println("  No problems found.")
+		return nil
+	}
+	fmt.Printf("  %d error(s), %d warning(s)\n", d.errors, d.warnings)
+	if d.errors > 0 {
+		os.Exit(1)
+	}
+	return nil
+}
+
+func contains(list []string, item string) bool {
+	for _, s := range list {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
@@ -11,7 +11,7 @@ var helpCmd = &cobra.Command{
 	Short: "Show help and examples",
 	Long:  "Display detailed help information with examples for all commands.",
 	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println(`
+		fmt.Print(`
 Preflight CLI - Launch readiness checker for your codebase
 
 USAGE:
@@ -20,6 +20,9 @@ USAGE:
 COMMANDS:
   init          Initialize preflight configuration for your project
   scan          Run all enabled checks and report results
+  doctor        Validate config and environment without running checks
+  schema        Print a JSON Schema for preflight.yml
+  diff          Compare two saved JSON scan results
   ignore        Add a check to the ignore list
   unignore      Remove a check from the ignore list
   checks        List all available check IDs
@@ -30,13 +33,50 @@ EXAMPLES:
 
   Initialize a new project:
     $ preflight init
+    $ preflight init --format json   # write preflight.json
+    $ preflight init --stack next    # skip stack detection
 
   Run all checks:
     $ preflight scan
 
+  Audit a live site without a local repo (file-based checks are skipped):
+    $ preflight scan --url https://example.com
+
+  Stop after two minutes, reporting unfinished checks as timed out:
+    $ preflight scan --deadline 2m
+
+  Use a config file outside the current directory tree:
+    $ preflight scan --config ../shared/preflight.yml
+
+  Read a generated config from stdin and scan the current directory:
+    $ ./gen-config.sh | preflight scan --config -
+
   Run in CI mode with JSON output:
     $ preflight scan --ci --format json
 
+  Save a JSON report for archiving while showing the text report:
+    $ preflight scan --ci --format json -o report.json
+
+  Stream one JSON object per result as checks finish, then a summary:
+    $ preflight scan --ci --format ndjson
+
+  Disable colored output (or set NO_COLOR=1):
+    $ preflight scan --no-color
+
+  Only show warnings, errors and the summary:
+    $ preflight scan --quiet
+
+  Run silently from a git hook; only the exit code is set:
+    $ preflight scan --check
+
+  Example .git/hooks/pre-commit (blocks on errors, allows warnings):
+    #!/bin/sh
+    preflight scan --check
+    [ $? -lt 2 ] || { echo "preflight: errors found, run 'preflight scan'"; exit 1; }
+
+  Post a summary to Slack or Discord when checks fail:
+    $ preflight scan --notify https://hooks.slack.com/services/...
+
   Silence a specific check:
     $ preflight ignore sitemap
     $ preflight ignore llmsTxt
@@ -48,13 +88,25 @@ EXAMPLES:
   List all check IDs:
     $ preflight checks
 
+  Find typos, bad URLs and missing files in your config:
+    $ preflight doctor
+
+  Enable editor autocompletion for preflight.yml:
+    $ preflight schema > preflight.schema.json
+
+  Compare two saved JSON reports (exits 1 on new failures):
+    $ preflight diff main.json new.json
+
 EXIT CODES:
   0  All checks passed
   1  Warnings only
   2  Errors found
 
 CONFIGURATION:
-  Preflight uses a preflight.yml file in your project root.
+  Preflight uses a preflight.yml file in your project root
+  (preflight.yaml and preflight.json are also accepted, in that order).
+  From a subdirectory, parent directories are searched up to the repo root.
+  Unknown keys are an error; pass --lenient to scan to ignore them.
   Run 'preflight init' to generate one automatically.
 
   To silence checks via config, add an ignore list:
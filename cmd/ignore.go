@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/preflightsh/preflight/internal/checks"
+	"github.com/preflightsh/preflight/internal/config"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 )
@@ -30,12 +34,20 @@ func init() {
 func runIgnore(cmd *cobra.Command, args []string) error {
 	checkID := args[0]
 
+	// Ignoring a misspelled ID would leave the intended check running
+	if !contains(checks.IDs(), checkID) {
+		return unknownCheckIDError(checkID, checks.IDs())
+	}
+
 	cwd, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	configPath := filepath.Join(cwd, "preflight.yml")
+	configPath, err := config.Find(cwd)
+	if err != nil {
+		return fmt.Errorf("preflight.yml not found. Run 'preflight init' first")
+	}
 
 	// Read existing config
 	data, err := os.ReadFile(configPath)
@@ -48,7 +60,7 @@ func runIgnore(cmd *cobra.Command, args []string) error {
 
 	// Parse as generic map to preserve structure
 	var cfg map[string]interface{}
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
+	if err := unmarshalConfigMap(configPath, data, &cfg); err != nil {
 		return fmt.Errorf("failed to parse preflight.yml: %w", err)
 	}
 
@@ -77,7 +89,7 @@ func runIgnore(cmd *cobra.Command, args []string) error {
 	cfg["ignore"] = ignoreList
 
 	// Write back
-	newData, err := yaml.Marshal(cfg)
+	newData, err := marshalConfigMap(configPath, cfg)
 	if err != nil {
 		return fmt.Errorf("failed to serialize config: %w", err)
 	}
@@ -86,10 +98,53 @@ func runIgnore(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
 
-	fmt.Printf("Added '%s' to ignore list\n", checkID)
+	fmt.Printf("Added '%s' to ignore list in %s\n", checkID, configPath)
 	return nil
 }
 
+// unknownCheckIDError describes an ID that matches nothing in candidates,
+// suggesting the closest one
+func unknownCheckIDError(checkID string, candidates []string) error {
+	msg := fmt.Sprintf("unknown check ID '%s'", checkID)
+	if suggestion := config.Suggest(checkID, candidates); suggestion != "" {
+		msg += fmt.Sprintf(", did you mean '%s'?", suggestion)
+	}
+	return fmt.Errorf("%s\nRun 'preflight checks' to list all IDs", msg)
+}
+
+// unknownIgnoreEntries returns ignore entries that match no registered check
+func unknownIgnoreEntries(ignore []string) []string {
+	var unknown []string
+	ids := checks.IDs()
+	for _, id := range ignore {
+		if !contains(ids, id) {
+			unknown = append(unknown, id)
+		}
+	}
+	return unknown
+}
+
+// unmarshalConfigMap parses a config file as a generic map, using JSON or
+// YAML depending on the file extension
+func unmarshalConfigMap(path string, data []byte, cfg *map[string]interface{}) error {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return json.Unmarshal(data, cfg)
+	}
+	return yaml.Unmarshal(data, cfg)
+}
+
+// marshalConfigMap serializes a generic config map in the file's format
+func marshalConfigMap(path string, cfg map[string]interface{}) ([]byte, error) {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		data, err := json.MarshalIndent(cfg, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	}
+	return yaml.Marshal(cfg)
+}
+
 // Also add an unignore command
 var unignoreCmd = &cobra.Command{
 	Use:   "unignore <check-id>",
@@ -114,7 +169,10 @@ func runUnignore(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	configPath := filepath.Join(cwd, "preflight.yml")
+	configPath, err := config.Find(cwd)
+	if err != nil {
+		return fmt.Errorf("preflight.yml not found. Run 'preflight init' first")
+	}
 
 	data, err := os.ReadFile(configPath)
 	if err != nil {
@@ -125,7 +183,7 @@ func runUnignore(cmd *cobra.Command, args []string) error {
 	}
 
 	var cfg map[string]interface{}
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
+	if err := unmarshalConfigMap(configPath, data, &cfg); err != nil {
 		return fmt.Errorf("failed to parse preflight.yml: %w", err)
 	}
 
@@ -153,7 +211,11 @@ func runUnignore(cmd *cobra.Command, args []string) error {
 	}
 
 	if !found {
-		fmt.Printf("'%s' is not in the ignore list\n", checkID)
+		msg := fmt.Sprintf("'%s' is not in the ignore list", checkID)
+		if suggestion := config.Suggest(checkID, ignoreList); suggestion != "" {
+			msg += fmt.Sprintf(", did you mean '%s'?", suggestion)
+		}
+		fmt.Println(msg)
 		return nil
 	}
 
@@ -164,7 +226,7 @@ func runUnignore(cmd *cobra.Command, args []string) error {
 		delete(cfg, "ignore")
 	}
 
-	newData, err := yaml.Marshal(cfg)
+	newData, err := marshalConfigMap(configPath, cfg)
 	if err != nil {
 		return fmt.Errorf("failed to serialize config: %w", err)
 	}
@@ -173,7 +235,7 @@ func runUnignore(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
 
-	fmt.Printf("Removed '%s' from ignore list\n", checkID)
+	fmt.Printf("Removed '%s' from ignore list in %s\n", checkID, configPath)
 	return nil
 }
 
@@ -181,7 +243,18 @@ func runUnignore(cmd *cobra.Command, args []string) error {
 var listChecksCmd = &cobra.Command{
 	Use:   "checks",
 	Short: "List all available check and service IDs that can be ignored",
+	Long: `List all available check and service IDs that can be ignored.
+
+Use --categories to group every check by category (SEO, SECURITY, PERF...)
+with a count for each, and --json for machine-readable output:
+
+  preflight checks --categories
+  preflight checks --categories --json`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if listCategoriesFlag || listJSONFlag {
+			return printCheckListing()
+		}
+
 		fmt.Println("=== Checks ===")
 		fmt.Println()
 
@@ -193,6 +266,11 @@ var listChecksCmd = &cobra.Command{
 		fmt.Println("  - ogTwitter")
 		fmt.Println("  - viewport")
 		fmt.Println("  - lang")
+		fmt.Println("  - hreflang")
+		fmt.Println("  - robots_meta")
+		fmt.Println("  - duplicate_meta")
+		fmt.Println("  - canonical_host")
+		fmt.Println("  - duplicate_page_meta")
 		fmt.Println()
 
 		fmt.Println("Security & Infrastructure:")
@@ -201,11 +279,24 @@ var listChecksCmd = &cobra.Command{
 		fmt.Println("  - www_redirect")
 		fmt.Println("  - email_auth (opt-in)")
 		fmt.Println("  - secrets")
+		fmt.Println("  - http_version")
+		fmt.Println("  - cors")
+		fmt.Println("  - open_redirect")
+		fmt.Println("  - csp")
+		fmt.Println("  - sri")
+		fmt.Println("  - trailing_slash")
+		fmt.Println("  - dockerfile")
+		fmt.Println("  - docker_compose")
+		fmt.Println("  - basic_auth")
+		fmt.Println("  - stripe_keys")
+		fmt.Println("  - reachable")
 		fmt.Println()
 
 		fmt.Println("Environment & Health:")
 		fmt.Println("  - envParity")
 		fmt.Println("  - healthEndpoint")
+		fmt.Println("  - prod_config")
+		fmt.Println("  - error_monitoring")
 		fmt.Println()
 
 		fmt.Println("Code Quality & Performance:")
@@ -213,6 +304,18 @@ var listChecksCmd = &cobra.Command{
 		fmt.Println("  - debug_statements")
 		fmt.Println("  - error_pages")
 		fmt.Println("  - image_optimization")
+		fmt.Println("  - hardcoded_urls")
+		fmt.Println("  - todo_markers")
+		fmt.Println("  - outdated_deps")
+		fmt.Println("  - lockfile")
+		fmt.Println("  - build_output")
+		fmt.Println("  - minified_assets")
+		fmt.Println()
+
+		fmt.Println("Analytics & Privacy:")
+		fmt.Println("  - duplicate_analytics")
+		fmt.Println("  - tracking_consent")
+		fmt.Println("  - analytics")
 		fmt.Println()
 
 		fmt.Println("Legal & Compliance:")
@@ -235,7 +338,7 @@ var listChecksCmd = &cobra.Command{
 		fmt.Println()
 
 		fmt.Println("Payments:")
-		fmt.Println("  - stripe: Verifies API keys, webhook secret, SDK initialization")
+		fmt.Println("  - stripe: Verifies API keys, webhook secret, SDK initialization, webhook rejects bad signatures")
 		fmt.Println("  - paypal: Verifies PayPal SDK or API integration")
 		fmt.Println("  - braintree: Verifies Braintree SDK initialization")
 		fmt.Println("  - paddle: Verifies Paddle.js initialization")
@@ -275,7 +378,7 @@ var listChecksCmd = &cobra.Command{
 		fmt.Println("Analytics:")
 		fmt.Println("  - plausible: Verifies Plausible script tag in templates")
 		fmt.Println("  - fathom: Verifies Fathom script tag in templates")
-		fmt.Println("  - google_analytics: Verifies GA/GTM script in templates")
+		fmt.Println("  - google_analytics: Verifies GA/GTM script in templates and warns on UA-only properties")
 		fmt.Println("  - fullres: Verifies Fullres script in templates")
 		fmt.Println("  - datafast: Verifies Datafa.st script in templates")
 		fmt.Println("  - posthog: Verifies posthog.init() initialization")
@@ -285,6 +388,12 @@ var listChecksCmd = &cobra.Command{
 		fmt.Println("  - hotjar: Verifies Hotjar tracking code in templates")
 		fmt.Println()
 
+		fmt.Println("Ad Pixels:")
+		fmt.Println("  - meta_pixel: Verifies the Meta (Facebook) Pixel snippet and reports its pixel ID")
+		fmt.Println("  - tiktok_pixel: Verifies the TikTok Pixel snippet and reports its pixel ID")
+		fmt.Println("  - linkedin_insight: Verifies the LinkedIn Insight Tag and reports its partner ID")
+		fmt.Println()
+
 		fmt.Println("Auth:")
 		fmt.Println("  - auth0: Verifies Auth0 SDK/API configuration")
 		fmt.Println("  - clerk: Verifies Clerk SDK initialization")
@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	"github.com/preflightsh/preflight/internal/config"
+	stackdetect "github.com/preflightsh/preflight/internal/stack"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 )
@@ -25,11 +26,27 @@ then generating a preflight.yml configuration file.`,
 	RunE: runInit,
 }
 
+var (
+	initFormat string
+	initStack  string
+)
+
 func init() {
 	rootCmd.AddCommand(initCmd)
+	initCmd.Flags().StringVar(&initFormat, "format", "yaml", "Config file format: yaml or json")
+	initCmd.Flags().StringVar(&initStack, "stack", "", "Skip stack detection and use this stack (e.g. next, rails, hugo)")
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
+	configPath := "preflight.yml"
+	switch initFormat {
+	case "yaml", "yml":
+	case "json":
+		configPath = "preflight.json"
+	default:
+		return fmt.Errorf("unknown format %q (use yaml or json)", initFormat)
+	}
+
 	CheckForUpdates()
 
 	reader := bufio.NewReader(os.Stdin)
@@ -43,14 +60,23 @@ func runInit(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get current directory: %w", err)
 	}
 
-	// Detect stack
-	fmt.Print("Detecting stack... ")
-	stack := config.DetectStack(cwd)
-	stackDisplay := formatStackName(stack)
-	if version := detectStackVersion(cwd, stack); version != "" {
-		stackDisplay += " " + version
+	// Detect stack (or take it from --stack)
+	var stack string
+	if initStack != "" {
+		stack = strings.ToLower(initStack)
+		if err := validateStack(stack); err != nil {
+			return err
+		}
+		fmt.Printf("Stack: %s (from --stack)\n", formatStackName(stack))
+	} else {
+		fmt.Print("Detecting stack... ")
+		stack = stackdetect.DetectStack(cwd)
+		stackDisplay := formatStackName(stack)
+		if version := detectStackVersion(cwd, stack); version != "" {
+			stackDisplay += " " + version
+		}
+		fmt.Printf("detected: %s\n", stackDisplay)
 	}
-	fmt.Printf("detected: %s\n", stackDisplay)
 
 	// Detect services
 	fmt.Println("Detecting services...")
@@ -73,6 +99,20 @@ func runInit(cmd *cobra.Command, args []string) error {
 	// Get project name
 	projectName := promptWithDefault(reader, "Project name", getDefaultProjectName(cwd))
 
+	// Let the user correct the detected stack
+	if initStack == "" {
+		stack = strings.ToLower(promptWithDefault(reader, "Stack", stack))
+		// Keeping an undetected stack is fine; scan falls back to detection
+		if stack != "unknown" {
+			if err := validateStack(stack); err != nil {
+				return err
+			}
+		}
+	}
+	if layout := detectMainLayout(cwd, stack); layout != "" {
+		fmt.Printf("  Main layout: %s\n", layout)
+	}
+
 	// Get URLs
 	fmt.Println()
 	stagingURL := normalizeURL(promptOptional(reader, "Staging URL (optional)"))
@@ -182,7 +222,6 @@ func runInit(cmd *cobra.Command, args []string) error {
 	}
 
 	// Write config file
-	configPath := "preflight.yml"
 	if err := writeConfig(configPath, &cfg); err != nil {
 		return fmt.Errorf("failed to write config: %w", err)
 	}
@@ -194,9 +233,9 @@ func runInit(cmd *cobra.Command, args []string) error {
 	gitignorePath := filepath.Join(cwd, ".gitignore")
 	gitignoreUpdated := false
 	if content, err := os.ReadFile(gitignorePath); err == nil {
-		// .gitignore exists, check if preflight.yml is already in it
-		if !strings.Contains(string(content), "preflight.yml") {
-			if promptYesNo(reader, "Add preflight.yml to .gitignore?", true) {
+		// .gitignore exists, check if the config file is already in it
+		if !strings.Contains(string(content), configPath) {
+			if promptYesNo(reader, fmt.Sprintf("Add %s to .gitignore?", configPath), true) {
 				// Append to .gitignore
 				f, err := os.OpenFile(gitignorePath, os.O_APPEND|os.O_WRONLY, 0644)
 				if err == nil {
@@ -204,31 +243,31 @@ func runInit(cmd *cobra.Command, args []string) error {
 					if len(content) > 0 && content[len(content)-1] != '\n' {
 						f.WriteString("\n")
 					}
-					f.WriteString("preflight.yml\n")
+					f.WriteString(configPath + "\n")
 					f.Close()
 					gitignoreUpdated = true
-					fmt.Println("✅ Added preflight.yml to .gitignore")
+					fmt.Printf("✅ Added %s to .gitignore\n", configPath)
 				}
 			}
 		}
 	} else if os.IsNotExist(err) {
 		// No .gitignore exists, offer to create one
-		if promptYesNo(reader, "Create .gitignore with preflight.yml?", true) {
-			os.WriteFile(gitignorePath, []byte("preflight.yml\n"), 0644)
+		if promptYesNo(reader, fmt.Sprintf("Create .gitignore with %s?", configPath), true) {
+			os.WriteFile(gitignorePath, []byte(configPath+"\n"), 0644)
 			gitignoreUpdated = true
-			fmt.Println("✅ Created .gitignore with preflight.yml")
+			fmt.Printf("✅ Created .gitignore with %s\n", configPath)
 		}
 	}
 
 	if !gitignoreUpdated {
 		fmt.Println()
-		fmt.Println("⚠️  Remember: preflight.yml may contain sensitive URLs.")
+		fmt.Printf("⚠️  Remember: %s may contain sensitive URLs.\n", configPath)
 		fmt.Println("   Consider adding it to your .gitignore")
 	}
 
 	fmt.Println()
 	fmt.Println("Next steps:")
-	fmt.Println("  1. Review and customize preflight.yml")
+	fmt.Printf("  1. Review and customize %s\n", configPath)
 	fmt.Println("  2. Run 'preflight scan' to check your project")
 	fmt.Println()
 
@@ -241,6 +280,19 @@ func runInit(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// validateStack rejects a stack that config wouldn't accept, suggesting the
+// closest supported one
+func validateStack(s string) error {
+	if contains(stackdetect.Supported, s) {
+		return nil
+	}
+	msg := fmt.Sprintf("unknown stack %q", s)
+	if suggestion := config.Suggest(s, stackdetect.Supported); suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+	return fmt.Errorf("%s\nValid stacks: %s", msg, strings.Join(stackdetect.Supported, ", "))
+}
+
 func promptWithDefault(reader *bufio.Reader, prompt, defaultVal string) string {
 	fmt.Printf("%s [%s]: ", prompt, defaultVal)
 	input, _ := reader.ReadString('\n')
@@ -482,7 +534,14 @@ func detectMainLayout(cwd, stack string) string {
 }
 
 func writeConfig(path string, cfg *config.PreflightConfig) error {
-	data, err := yaml.Marshal(cfg)
+	var data []byte
+	var err error
+	if strings.HasSuffix(path, ".json") {
+		data, err = json.MarshalIndent(cfg, "", "  ")
+		data = append(data, '\n')
+	} else {
+		data, err = yaml.Marshal(cfg)
+	}
 	if err != nil {
 		return err
 	}
@@ -535,6 +594,11 @@ func formatServiceName(svc string) string {
 		"segment":          "Segment",
 		"hotjar":           "Hotjar",
 
+		// Ad Pixels
+		"meta_pixel":       "Meta Pixel",
+		"tiktok_pixel":     "TikTok Pixel",
+		"linkedin_insight": "LinkedIn Insight Tag",
+
 		// Auth
 		"auth0":    "Auth0",
 		"clerk":    "Clerk",
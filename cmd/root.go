@@ -27,6 +27,7 @@ func Execute() error {
 
 func init() {
 	rootCmd.SetVersionTemplate("preflight version {{.Version}}\n")
+	rootCmd.PersistentFlags().BoolVar(&noUpdateCheck, "no-update-check", false, "Don't check for a newer version (also PREFLIGHT_NO_UPDATE=1)")
 }
 
 func exitWithError(msg string) {
@@ -1,21 +1,42 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
-	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
 	"time"
 
 	"github.com/preflightsh/preflight/internal/checks"
 	"github.com/preflightsh/preflight/internal/config"
 	"github.com/preflightsh/preflight/internal/output"
+	"github.com/preflightsh/preflight/internal/stack"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 var (
-	ciMode      bool
-	formatFlag  string
-	verboseFlag bool
+	ciMode       bool
+	formatFlag   string
+	verboseFlag  bool
+	noColorFlag  bool
+	quietFlag    bool
+	showPassed   bool
+	notifyURL    string
+	notifyAlways bool
+	configFlag   string
+	checkMode    bool
+	lenientFlag  bool
+	offlineFlag  bool
+	userAgent    string
+	stackFlag    string
+	urlFlag      string
+	deadlineFlag time.Duration
+	outputFlag   string
+	strictFlag   bool
 )
 
 var scanCmd = &cobra.Command{
@@ -30,48 +51,231 @@ Exits with code 0 for success, 1 for warnings only, 2 for errors.`,
 func init() {
 	rootCmd.AddCommand(scanCmd)
 	scanCmd.Flags().BoolVar(&ciMode, "ci", false, "Run in CI mode (no interactivity)")
-	scanCmd.Flags().StringVar(&formatFlag, "format", "human", "Output format: human or json")
+	scanCmd.Flags().StringVar(&formatFlag, "format", "text", "Output format: "+strings.Join(output.Formats(), ", "))
+	scanCmd.Flags().StringVarP(&outputFlag, "output", "o", "", "Write the --format report to this file and show the text report on stdout")
 	scanCmd.Flags().BoolVarP(&verboseFlag, "verbose", "v", false, "Show detailed information about each check")
+	scanCmd.Flags().BoolVar(&noColorFlag, "no-color", false, "Disable colored output (also honors NO_COLOR)")
+	scanCmd.Flags().BoolVarP(&quietFlag, "quiet", "q", false, "Only show warnings, errors and the summary")
+	scanCmd.Flags().BoolVar(&showPassed, "show-passed", false, "Show passed checks even in quiet mode")
+	scanCmd.Flags().BoolVar(&checkMode, "check", false, "Print nothing and only set the exit code (for git hooks; implies --ci)")
+	scanCmd.Flags().StringVar(&configFlag, "config", "", "Path to preflight.yml (skips searching parent directories); - reads it from stdin")
+	scanCmd.Flags().BoolVar(&lenientFlag, "lenient", false, "Ignore unknown keys in preflight.yml instead of failing")
+	scanCmd.Flags().StringVar(&notifyURL, "notify", "", "Slack or Discord webhook URL to POST a summary to when checks fail")
+	scanCmd.Flags().BoolVar(&notifyAlways, "notify-always", false, "Send the --notify summary even when all checks pass")
+	scanCmd.Flags().BoolVar(&offlineFlag, "offline", false, "Skip checks that need the network (live URLs, DNS, package registries)")
+	scanCmd.Flags().StringVar(&stackFlag, "stack", "", "Use this stack for one run instead of the config's (e.g. next, rails, hugo)")
+	scanCmd.Flags().StringVar(&urlFlag, "url", "", "Scan a live site with no local repo (runs only URL, HTTP and DNS checks)")
+	scanCmd.Flags().BoolVar(&strictFlag, "strict", false, "Exit with code 2 when a check errors (breaks while running) instead of only reporting it")
+	scanCmd.Flags().DurationVar(&deadlineFlag, "deadline", 0, "Time budget for the whole scan, e.g. 90s or 5m; checks still running are reported as timed out (0 = no limit)")
+	scanCmd.Flags().StringVar(&userAgent, "user-agent", "", "User-Agent for requests to your site (overrides http.userAgent)")
 }
 
 func runScan(cmd *cobra.Command, args []string) error {
-	if !ciMode {
-		CheckForUpdates()
+	// --check is for git hooks: never prompt, never print
+	if checkMode {
+		ciMode = true
+	}
+
+	// Look for a newer version while the checks run; --check stays silent
+	// and --offline makes no requests at all
+	var pendingUpdate <-chan string
+	if !checkMode && !offlineFlag {
+		pendingUpdate = StartUpdateCheck()
 	}
 
 	// Use provided path or current directory
-	var projectDir string
+	var startDir string
 	if len(args) > 0 {
-		projectDir = args[0]
+		startDir = args[0]
 	} else {
 		var err error
-		projectDir, err = os.Getwd()
+		startDir, err = os.Getwd()
 		if err != nil {
 			return fmt.Errorf("failed to get current directory: %w", err)
 		}
 	}
 
-	// Load config
-	cfg, err := config.Load(projectDir)
-	if err != nil {
+	// --url audits a live site with no local repo, so there is no config to
+	// find and no project directory to read
+	var cfg *config.PreflightConfig
+	var projectDir, tempDir string
+	var err error
+
+	// exitScan removes the --url stand-in directory before exiting, since
+	// os.Exit skips deferred calls
+	exitScan := func(code int) {
+		if tempDir != "" {
+			os.RemoveAll(tempDir)
+		}
+		os.Exit(code)
+	}
+
+	if urlFlag != "" {
+		if len(args) > 0 {
+			err = fmt.Errorf("--url scans a live site and takes no path")
+		} else if offlineFlag {
+			err = fmt.Errorf("--url needs the network and can't be combined with --offline")
+		} else {
+			cfg, projectDir, err = remoteScanConfig(urlFlag)
+			tempDir = projectDir
+		}
+		if err != nil {
+			if !ciMode {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			exitScan(2)
+		}
+	} else {
+		// Locate config: an explicit --config wins, otherwise walk up from startDir.
+		// The project root is the directory holding the config unless a path was given.
+		cfgFile := configFlag
+		if cfgFile == "" {
+			found, err := config.Find(startDir)
+			if err != nil {
+				if !ciMode {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					fmt.Fprintln(os.Stderr, "Run 'preflight init' to create a configuration file.")
+				}
+				exitScan(2)
+			}
+			cfgFile = found
+		}
+
+		projectDir = filepath.Dir(cfgFile)
+		if len(args) > 0 || cfgFile == config.StdinPath {
+			// A config read from stdin has no directory: scan the path or cwd
+			projectDir = startDir
+		}
+
+		// Load config
+		loadConfig := config.LoadFile
+		if lenientFlag {
+			loadConfig = config.LoadFileLenient
+		}
+		cfg, err = loadConfig(cfgFile)
+		if err != nil {
+			if !ciMode {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			exitScan(2)
+		}
+	}
+
+	// A misspelled ignore entry leaves the intended check running
+	if !checkMode {
+		ids := checks.IDs()
+		for _, id := range unknownIgnoreEntries(cfg.Ignore) {
+			msg := fmt.Sprintf("Warning: ignore entry '%s' matches no check", id)
+			if suggestion := config.Suggest(id, ids); suggestion != "" {
+				msg += fmt.Sprintf(", did you mean '%s'?", suggestion)
+			}
+			fmt.Fprintln(os.Stderr, msg)
+		}
+		for _, id := range unknownIgnoreEntries(cfg.Required) {
+			msg := fmt.Sprintf("Warning: required entry '%s' matches no check", id)
+			if suggestion := config.Suggest(id, ids); suggestion != "" {
+				msg += fmt.Sprintf(", did you mean '%s'?", suggestion)
+			}
+			fmt.Fprintln(os.Stderr, msg)
+		}
+		for _, id := range cfg.Required {
+			if contains(cfg.Ignore, id) {
+				fmt.Fprintf(os.Stderr, "Warning: required check '%s' is also ignored and won't run\n", id)
+			}
+		}
+	}
+
+	// Expand paths globs so file-based checks can be scoped to apps in a monorepo
+	if len(cfg.Paths) > 0 {
+		paths, err := resolvePaths(projectDir, cfg.Paths)
+		if err != nil {
+			if !ciMode {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			}
+			exitScan(2)
+		}
+		cfg.Paths = paths
+	}
+
+	// --stack overrides the config for this run
+	if stackFlag != "" {
+		s := strings.ToLower(stackFlag)
+		if !contains(stack.Supported, s) {
+			if !ciMode {
+				msg := fmt.Sprintf("Error: unknown stack %q", stackFlag)
+				if suggestion := config.Suggest(s, stack.Supported); suggestion != "" {
+					msg += fmt.Sprintf(", did you mean %q?", suggestion)
+				}
+				fmt.Fprintln(os.Stderr, msg)
+				fmt.Fprintf(os.Stderr, "Valid stacks: %s\n", strings.Join(stack.Supported, ", "))
+			}
+			exitScan(2)
+		}
+		cfg.Stack = s
+	}
+
+	// Fall back to detecting the stack when the config doesn't name one,
+	// looking in the first scoped app when paths are set
+	if cfg.Stack == "" || cfg.Stack == "unknown" {
+		stackDir := projectDir
+		if len(cfg.Paths) > 0 {
+			stackDir = filepath.Join(projectDir, cfg.Paths[0])
+		}
+		cfg.Stack = stack.DetectStack(stackDir)
+	}
+
+	// The flag wins over the config so a blocked scan can be retried without edits
+	if userAgent != "" {
+		cfg.HTTP.UserAgent = userAgent
+	}
+	if err := checks.SetProxy(cfg.HTTP.Proxy); err != nil {
+		if !ciMode {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		exitScan(2)
+	}
+	if err := checks.SetRateLimit(cfg.HTTP.RateLimit); err != nil {
 		if !ciMode {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			fmt.Fprintln(os.Stderr, "Run 'preflight init' to create a configuration file.")
 		}
-		os.Exit(2)
+		exitScan(2)
+	}
+
+	// --deadline bounds the whole scan; requests still in flight when it
+	// runs out are cancelled
+	if deadlineFlag < 0 {
+		if !ciMode {
+			fmt.Fprintf(os.Stderr, "Error: --deadline must not be negative, got %s\n", deadlineFlag)
+		}
+		exitScan(2)
+	}
+	scanCtx := context.Background()
+	if deadlineFlag > 0 {
+		var cancel context.CancelFunc
+		scanCtx, cancel = context.WithTimeout(scanCtx, deadlineFlag)
+		defer cancel()
 	}
 
-	// Create HTTP client with timeout
-	httpClient := &http.Client{
-		Timeout: 2 * time.Second,
+	// Create HTTP client with timeout, going through http.proxy or HTTP(S)_PROXY
+	httpClient := checks.WithDeadline(checks.WithUserAgent(checks.NewHTTPClient(2*time.Second), cfg.HTTP.UserAgent), scanCtx)
+	if offlineFlag {
+		// Checks see a nil client and skip or fall back to project files
+		httpClient = nil
 	}
 
+	// Load .gitignore/.preflightignore rules shared by checks that walk files
+	checks.LoadIgnoreRules(projectDir, cfg.SkipDirs)
+
 	// Create check context
 	ctx := checks.Context{
+		Context: scanCtx,
 		RootDir: projectDir,
 		Config:  cfg,
 		Client:  httpClient,
 		Verbose: verboseFlag,
+		Files:   checks.NewFileIndex(projectDir, cfg.Paths...),
+
+		NoSource: urlFlag != "",
 	}
 
 	// Build list of enabled checks
@@ -92,49 +296,149 @@ func runScan(cmd *cobra.Command, args []string) error {
 		enabledChecks = filtered
 	}
 
-	// Run all checks
-	var results []checks.CheckResult
-	for _, check := range enabledChecks {
-		result, err := check.Run(ctx)
+	// Choose output format (none in --check mode, which only sets the exit code).
+	// With --output the format goes to the file and stdout gets the text report.
+	var outputters []output.Outputter
+	var reportFile *os.File
+	textOnStdout := false
+	if !checkMode || outputFlag != "" {
+		opts := output.Options{
+			Verbose: verboseFlag,
+			NoColor: !useColor(),
+			Quiet:   quietFlag && !showPassed,
+			Version: version,
+			Strict:  strictFlag,
+		}
+		display := opts
+		if outputFlag != "" {
+			if reportFile, err = os.Create(outputFlag); err != nil {
+				if !ciMode {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				}
+				exitScan(2)
+			}
+			opts.Writer = reportFile
+			opts.NoColor = true
+		}
+
+		outputter, err := output.New(formatFlag, opts)
 		if err != nil {
-			// Convert error to failed check result
-			result = checks.CheckResult{
-				ID:       check.ID(),
-				Title:    check.Title(),
-				Severity: checks.SeverityError,
-				Passed:   false,
-				Message:  fmt.Sprintf("Check failed: %v", err),
+			if !ciMode {
+				msg := fmt.Sprintf("Error: %v", err)
+				if suggestion := config.Suggest(strings.ToLower(formatFlag), output.Formats()); suggestion != "" {
+					msg += fmt.Sprintf(", did you mean %q?", suggestion)
+				}
+				fmt.Fprintln(os.Stderr, msg)
+				fmt.Fprintf(os.Stderr, "Valid formats: %s\n", strings.Join(output.Formats(), ", "))
+			}
+			exitScan(2)
+		}
+		outputters = append(outputters, outputter)
+		_, textOnStdout = outputter.(output.TextOutputter)
+
+		if outputFlag != "" {
+			textOnStdout = false
+			if !checkMode {
+				text, _ := output.New("text", display)
+				outputters = append(outputters, text)
+				textOnStdout = true
 			}
 		}
-		results = append(results, result)
 	}
 
-	// Output results
-	var outputter output.Outputter
-	if formatFlag == "json" {
-		outputter = output.JSONOutputter{}
-	} else {
-		outputter = output.HumanOutputter{Verbose: verboseFlag}
+	// Checks that judge other checks' results run last
+	var aggregates []checks.Check
+	var regular []checks.Check
+	for _, check := range enabledChecks {
+		if aggregate, ok := check.(checks.AggregateCheck); ok && aggregate.Aggregates() {
+			aggregates = append(aggregates, check)
+		} else {
+			regular = append(regular, check)
+		}
 	}
 
-	outputter.Output(cfg.ProjectName, results)
+	regular = orderByDependencies(regular)
+
+	// Run all checks, streaming results to outputters that support it
+	var results []checks.CheckResult
+	ran := make(map[string][]checks.CheckResult)
+	for i, check := range append(regular, aggregates...) {
+		if i >= len(regular) {
+			ctx.Results = results
+		}
+		checkResults := runCheck(check, ctx, ran)
+		// Mask credentials before any result is kept, streamed, or reported
+		checks.RedactResults(checkResults)
+		ran[check.ID()] = append(ran[check.ID()], checkResults...)
+		applyRequired(checkResults, cfg.Required)
+		for _, outputter := range outputters {
+			if streamer, ok := outputter.(output.StreamingOutputter); ok {
+				for _, r := range checkResults {
+					streamer.Result(r)
+				}
+			}
+		}
+		results = append(results, checkResults...)
+	}
 
-	// Show star message on first scan (only in human format, not JSON)
-	if formatFlag != "json" && isFirstRun("scan_done") {
+	// The --url project directory was only a stand-in
+	if tempDir != "" {
+		os.RemoveAll(tempDir)
+		tempDir = ""
+	}
+
+	// Output results
+	for _, outputter := range outputters {
+		if streamer, ok := outputter.(output.StreamingOutputter); ok {
+			streamer.Finish(cfg.ProjectName, results)
+		} else {
+			outputter.Output(cfg.ProjectName, results)
+		}
+	}
+	if reportFile != nil {
+		if err := reportFile.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: writing %s: %v\n", outputFlag, err)
+		}
+	}
+
+	// Show star message on first scan (only in text format, not JSON)
+	if textOnStdout && isFirstRun("scan_done") {
 		fmt.Println()
 		showStarMessage()
 		markFirstRunComplete("scan_done")
 	}
 
 	// Determine exit code
-	exitCode := determineExitCode(results)
+	exitCode := output.ExitCode(results, strictFlag)
+
+	// Send webhook notification on failures (or always, if requested)
+	if notifyURL != "" && (exitCode != 0 || notifyAlways) {
+		notifyClient := checks.WithUserAgent(checks.NewHTTPClient(10*time.Second), cfg.HTTP.UserAgent)
+		if err := output.Notify(notifyClient, notifyURL, cfg.ProjectName, results); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+	}
+
+	// The fetch started with the scan, so it has usually finished by now.
+	// Only a text-format, non-CI run may prompt to install.
+	FinishUpdateCheck(pendingUpdate, textOnStdout && !ciMode, time.Second)
+
 	if exitCode != 0 {
-		os.Exit(exitCode)
+		exitScan(exitCode)
 	}
 
 	return nil
 }
 
+// useColor reports whether human output should include ANSI colors.
+// Color is disabled by --no-color, a non-empty NO_COLOR, or a non-TTY stdout.
+func useColor() bool {
+	if noColorFlag || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
 func buildEnabledChecks(cfg *config.PreflightConfig, rootDir string) []checks.Check {
 	var enabledChecks []checks.Check
 
@@ -151,8 +455,10 @@ func buildEnabledChecks(cfg *config.PreflightConfig, rootDir string) []checks.Ch
 
 	// === SEO & Social ===
 	// Auto-enable SEO checks if layout can be detected or explicitly configured
-	seoEnabled := (cfg.Checks.SEOMeta != nil && cfg.Checks.SEOMeta.Enabled) ||
-		canAutoDetectLayout(rootDir, cfg.Stack)
+	seoEnabled := cfg.Checks.SEOMeta != nil && cfg.Checks.SEOMeta.Enabled
+	for _, dir := range scopedRoots(rootDir, cfg.Paths) {
+		seoEnabled = seoEnabled || canAutoDetectLayout(dir, cfg.Stack)
+	}
 	if seoEnabled {
 		enabledChecks = append(enabledChecks, checks.SEOMetadataCheck{})
 		enabledChecks = append(enabledChecks, checks.CanonicalURLCheck{})
@@ -161,21 +467,43 @@ func buildEnabledChecks(cfg *config.PreflightConfig, rootDir string) []checks.Ch
 		enabledChecks = append(enabledChecks, checks.LangAttributeCheck{})
 	}
 	enabledChecks = append(enabledChecks, checks.StructuredDataCheck{})
+	enabledChecks = append(enabledChecks, checks.HreflangCheck{})
+	enabledChecks = append(enabledChecks, checks.RobotsMetaCheck{})
+	enabledChecks = append(enabledChecks, checks.DuplicateMetaCheck{})
+	if cfg.URLs.Production != "" || cfg.URLs.Staging != "" {
+		enabledChecks = append(enabledChecks, checks.DuplicatePageMetaCheck{})
+	}
 	if cfg.Checks.IndexNow != nil && cfg.Checks.IndexNow.Enabled {
 		enabledChecks = append(enabledChecks, checks.IndexNowCheck{})
 	}
 
 	// === Security & Infrastructure ===
+	// Probed first so an unreachable site is reported once, not by every
+	// check that requests it
+	if cfg.URLs.Production != "" || cfg.URLs.Staging != "" {
+		enabledChecks = append(enabledChecks, checks.ReachabilityCheck{})
+	}
 	if cfg.Checks.Security != nil && cfg.Checks.Security.Enabled {
 		enabledChecks = append(enabledChecks, checks.SecurityHeadersCheck{})
+		enabledChecks = append(enabledChecks, checks.CSPCheck{})
+		enabledChecks = append(enabledChecks, checks.CORSCheck{})
+		enabledChecks = append(enabledChecks, checks.OpenRedirectCheck{})
 	}
 	if cfg.URLs.Production != "" {
 		enabledChecks = append(enabledChecks, checks.SSLCheck{})
 		enabledChecks = append(enabledChecks, checks.WWWRedirectCheck{})
+		enabledChecks = append(enabledChecks, checks.CanonicalHostCheck{})
+		enabledChecks = append(enabledChecks, checks.TrailingSlashCheck{})
+		enabledChecks = append(enabledChecks, checks.BasicAuthCheck{})
+		enabledChecks = append(enabledChecks, checks.HTTPVersionCheck{})
 	}
 	if cfg.Checks.EmailAuth != nil && cfg.Checks.EmailAuth.Enabled && cfg.URLs.Production != "" {
 		enabledChecks = append(enabledChecks, checks.EmailAuthCheck{})
 	}
+	enabledChecks = append(enabledChecks, checks.SRICheck{})
+	enabledChecks = append(enabledChecks, checks.DockerfileCheck{})
+	enabledChecks = append(enabledChecks, checks.DockerComposeCheck{})
+	enabledChecks = append(enabledChecks, checks.StripeKeysCheck{})
 	if cfg.Checks.Secrets != nil && cfg.Checks.Secrets.Enabled {
 		enabledChecks = append(enabledChecks, checks.SecretScanCheck{})
 	}
@@ -184,6 +512,8 @@ func buildEnabledChecks(cfg *config.PreflightConfig, rootDir string) []checks.Ch
 	if cfg.Checks.EnvParity != nil && cfg.Checks.EnvParity.Enabled {
 		enabledChecks = append(enabledChecks, checks.EnvParityCheck{})
 	}
+	enabledChecks = append(enabledChecks, checks.ProdConfigCheck{})
+	enabledChecks = append(enabledChecks, checks.ErrorMonitoringCheck{})
 	// Health check runs if explicitly enabled OR if any URLs are configured
 	if (cfg.Checks.HealthEndpoint != nil && cfg.Checks.HealthEndpoint.Enabled) ||
 		cfg.URLs.Production != "" || cfg.URLs.Staging != "" {
@@ -311,6 +641,17 @@ func buildEnabledChecks(cfg *config.PreflightConfig, rootDir string) []checks.Ch
 		enabledChecks = append(enabledChecks, checks.HotjarCheck{})
 	}
 
+	// Ad pixels
+	if cfg.Services["meta_pixel"].Declared && !serviceIgnored("meta_pixel") {
+		enabledChecks = append(enabledChecks, checks.MetaPixelCheck{})
+	}
+	if cfg.Services["tiktok_pixel"].Declared && !serviceIgnored("tiktok_pixel") {
+		enabledChecks = append(enabledChecks, checks.TikTokPixelCheck{})
+	}
+	if cfg.Services["linkedin_insight"].Declared && !serviceIgnored("linkedin_insight") {
+		enabledChecks = append(enabledChecks, checks.LinkedInInsightCheck{})
+	}
+
 	// Infrastructure
 	if cfg.Services["redis"].Declared && !serviceIgnored("redis") {
 		enabledChecks = append(enabledChecks, checks.RedisCheck{})
@@ -432,9 +773,22 @@ func buildEnabledChecks(cfg *config.PreflightConfig, rootDir string) []checks.Ch
 
 	// === Code Quality & Performance ===
 	enabledChecks = append(enabledChecks, checks.VulnerabilityCheck{})
+	enabledChecks = append(enabledChecks, checks.OutdatedDepsCheck{})
+	enabledChecks = append(enabledChecks, checks.LockfileCheck{})
+	enabledChecks = append(enabledChecks, checks.BuildOutputCheck{})
 	enabledChecks = append(enabledChecks, checks.DebugStatementsCheck{})
+	enabledChecks = append(enabledChecks, checks.HardcodedURLsCheck{})
+	enabledChecks = append(enabledChecks, checks.TodoMarkersCheck{})
 	enabledChecks = append(enabledChecks, checks.ErrorPagesCheck{})
 	enabledChecks = append(enabledChecks, checks.ImageOptimizationCheck{})
+	if cfg.URLs.Production != "" || cfg.URLs.Staging != "" {
+		enabledChecks = append(enabledChecks, checks.MinifiedAssetsCheck{})
+	}
+
+	// === Analytics & Privacy ===
+	enabledChecks = append(enabledChecks, checks.DuplicateAnalyticsCheck{})
+	enabledChecks = append(enabledChecks, checks.TrackingConsentCheck{})
+	enabledChecks = append(enabledChecks, checks.AnalyticsConfiguredCheck{})
 
 	// === Legal & Compliance ===
 	enabledChecks = append(enabledChecks, checks.LegalPagesCheck{})
@@ -457,28 +811,259 @@ func buildEnabledChecks(cfg *config.PreflightConfig, rootDir string) []checks.Ch
 	return enabledChecks
 }
 
-func determineExitCode(results []checks.CheckResult) int {
-	hasError := false
-	hasWarning := false
-
-	for _, r := range results {
-		if !r.Passed {
-			switch r.Severity {
-			case checks.SeverityError:
-				hasError = true
-			case checks.SeverityWarn:
-				hasWarning = true
+// remoteScanConfig builds the config for scan --url: the --config file if
+// given, otherwise an empty one, pointed at rawURL as production. The
+// project directory is a fresh empty temp dir so file-based checks find
+// nothing; the caller removes it.
+func remoteScanConfig(rawURL string) (*config.PreflightConfig, string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, "", fmt.Errorf("--url must be an absolute http(s) URL, got %q", rawURL)
+	}
+
+	cfg := &config.PreflightConfig{Stack: "unknown"}
+	if configFlag != "" {
+		loadConfig := config.LoadFile
+		if lenientFlag {
+			loadConfig = config.LoadFileLenient
+		}
+		if cfg, err = loadConfig(configFlag); err != nil {
+			return nil, "", err
+		}
+	}
+	if cfg.ProjectName == "" {
+		cfg.ProjectName = u.Host
+	}
+	cfg.URLs.Production = strings.TrimSuffix(u.String(), "/")
+	cfg.URLs.Staging = ""
+	cfg.Paths = nil
+
+	// Headers and page metadata can be judged from any live site
+	if cfg.Checks.Security == nil {
+		cfg.Checks.Security = &config.SecurityConfig{Enabled: true}
+	}
+	if cfg.Checks.SEOMeta == nil {
+		cfg.Checks.SEOMeta = &config.SEOMetaConfig{Enabled: true}
+	}
+
+	projectDir, err := os.MkdirTemp("", "preflight-url-")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create scan directory: %w", err)
+	}
+	return cfg, projectDir, nil
+}
+
+// runCheck runs a single check, expanding multi-result checks and reporting
+// errors and panics as errored results. ran holds the results so far, by
+// check ID, for checks with dependencies. A check still running when the scan
+// deadline runs out is reported as timed out.
+func runCheck(check checks.Check, ctx checks.Context, ran map[string][]checks.CheckResult) []checks.CheckResult {
+	if network, ok := check.(checks.NetworkCheck); ok && network.RequiresNetwork() && ctx.Offline() {
+		return []checks.CheckResult{checks.OfflineResult(check)}
+	}
+	if ctx.NoSource && !checks.RunsFromURL(check) {
+		return []checks.CheckResult{checks.NoSourceResult(check)}
+	}
+	if dependency, reason := unmetDependency(check, ran); dependency != "" {
+		return []checks.CheckResult{checks.DependencySkippedResult(check, dependency, reason)}
+	}
+	if ctx.Err() != nil {
+		return []checks.CheckResult{checks.TimedOutResult(check)}
+	}
+
+	// The check finishes before the next one starts, since checks share the
+	// file index, caches, and rate limiter. Its requests, DNS lookups, exec
+	// calls, and walks take ctx, so it returns soon after the deadline; what
+	// it reported by then is replaced with a timed-out result.
+	results := executeCheck(check, ctx)
+	if ctx.Err() != nil {
+		return []checks.CheckResult{checks.TimedOutResult(check)}
+	}
+	return results
+}
+
+// executeCheck calls the check's Run or RunMulti. A panic is recovered and
+// reported as an errored result so one broken check can't end the scan.
+func executeCheck(check checks.Check, ctx checks.Context) (results []checks.CheckResult) {
+	defer func() {
+		if v := recover(); v != nil {
+			details := append([]string{"Check: " + check.ID()}, panicStack(debug.Stack())...)
+			results = []checks.CheckResult{erroredResult(check, fmt.Sprintf("Check panicked: %v", v), details)}
+		}
+	}()
+
+	var err error
+	if multi, ok := check.(checks.MultiCheck); ok {
+		results, err = multi.RunMulti(ctx)
+	} else {
+		var result checks.CheckResult
+		result, err = check.Run(ctx)
+		results = []checks.CheckResult{result}
+	}
+
+	if err != nil {
+		return []checks.CheckResult{erroredResult(check, fmt.Sprintf("Check errored: %v", err), nil)}
+	}
+	return results
+}
+
+// erroredResult reports a check that broke while running. That's not a
+// finding about the project, so it's shown on its own and only affects the
+// exit code with --strict.
+func erroredResult(check checks.Check, message string, details []string) checks.CheckResult {
+	return checks.CheckResult{
+		ID:       check.ID(),
+		Title:    check.Title(),
+		Severity: checks.SeverityError,
+		Passed:   false,
+		Errored:  true,
+		Message:  message,
+		Suggestions: []string{
+			"This is a problem with preflight, not your project; please report it at https://github.com/preflightsh/preflight/issues",
+		},
+		Details: details,
+	}
+}
+
+// maxPanicStackLines bounds the stack trace kept for a panicking check
+const maxPanicStackLines = 16
+
+// panicStack trims a debug.Stack trace to the frames from the panic
+// onwards, dropping the goroutine header and the recover machinery
+func panicStack(stack []byte) []string {
+	lines := strings.Split(strings.TrimSpace(string(stack)), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "panic(") {
+			lines = lines[i:]
+			break
+		}
+	}
+	if len(lines) > maxPanicStackLines {
+		lines = append(lines[:maxPanicStackLines], "...")
+	}
+	for i := range lines {
+		lines[i] = strings.Replace(lines[i], "\t", "  ", 1)
+	}
+	return lines
+}
+
+// orderByDependencies moves each check's dependencies ahead of it, keeping
+// the order otherwise. A dependency cycle is broken where it's found.
+func orderByDependencies(list []checks.Check) []checks.Check {
+	byID := make(map[string]checks.Check)
+	for _, check := range list {
+		byID[check.ID()] = check
+	}
+
+	ordered := make([]checks.Check, 0, len(list))
+	visited := make(map[string]bool)
+	var visit func(check checks.Check)
+	visit = func(check checks.Check) {
+		if visited[check.ID()] {
+			return
+		}
+		visited[check.ID()] = true
+		if dependent, ok := check.(checks.DependentCheck); ok {
+			for _, id := range dependent.DependsOn() {
+				if dependency, ok := byID[id]; ok {
+					visit(dependency)
+				}
 			}
 		}
+		ordered = append(ordered, check)
+	}
+	for _, check := range list {
+		visit(check)
 	}
+	return ordered
+}
+
+// unmetDependency returns the first dependency of check that failed with an
+// error, errored without a verdict, or whose results were all skipped, and
+// why. A dependency that only warned still did its job. It returns
+// "" when the check may run. Dependencies that didn't run (disabled or
+// ignored) don't hold a check back.
+func unmetDependency(check checks.Check, ran map[string][]checks.CheckResult) (string, string) {
+	dependent, ok := check.(checks.DependentCheck)
+	if !ok {
+		return "", ""
+	}
+	for _, id := range dependent.DependsOn() {
+		results, found := ran[id]
+		if !found {
+			continue
+		}
+		skipped := true
+		for _, r := range results {
+			if r.Errored {
+				return id, "errored"
+			}
+			if !r.Passed && !r.Skipped && r.Severity == checks.SeverityError {
+				return id, "failed"
+			}
+			skipped = skipped && r.Skipped
+		}
+		if skipped {
+			return id, "was skipped"
+		}
+	}
+	return "", ""
+}
+
+// applyRequired raises failures of checks listed under required to errors,
+// so they block the deploy (exit 2) however noisy the check normally is.
+// Skipped results don't count as failures.
+func applyRequired(results []checks.CheckResult, required []string) {
+	for i, r := range results {
+		if !r.Passed && !r.Skipped && contains(required, r.ID) {
+			results[i].Severity = checks.SeverityError
+		}
+	}
+}
+
+// resolvePaths expands the glob patterns in the paths setting to directories
+// relative to projectDir. A pattern matching no directory is an error, since
+// the checks would otherwise silently scan nothing.
+func resolvePaths(projectDir string, patterns []string) ([]string, error) {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(projectDir, filepath.FromSlash(pattern)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid paths entry '%s': %w", pattern, err)
+		}
+		found := false
+		for _, match := range matches {
+			if info, err := os.Stat(match); err != nil || !info.IsDir() {
+				continue
+			}
+			rel, err := filepath.Rel(projectDir, match)
+			if err != nil || strings.HasPrefix(rel, "..") {
+				return nil, fmt.Errorf("paths entry '%s' is outside the project", pattern)
+			}
+			found = true
+			if !seen[rel] {
+				seen[rel] = true
+				paths = append(paths, filepath.ToSlash(rel))
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("paths entry '%s' matches no directory in %s", pattern, projectDir)
+		}
+	}
+	return paths, nil
+}
 
-	if hasError {
-		return 2
+// scopedRoots returns the directories file-based checks look in
+func scopedRoots(rootDir string, paths []string) []string {
+	if len(paths) == 0 {
+		return []string{rootDir}
 	}
-	if hasWarning {
-		return 1
+	var roots []string
+	for _, p := range paths {
+		roots = append(roots, filepath.Join(rootDir, p))
 	}
-	return 0
+	return roots
 }
 
 // canAutoDetectLayout checks if a layout file can be auto-detected for SEO checks
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/preflightsh/preflight/internal/checks"
+)
+
+// slowCheck outlives the scan deadline, then records that it finished
+type slowCheck struct {
+	finished *bool
+}
+
+func (c slowCheck) ID() string    { return "slow" }
+func (c slowCheck) Title() string { return "Slow check" }
+
+func (c slowCheck) Run(ctx checks.Context) (checks.CheckResult, error) {
+	<-ctx.Done()
+	time.Sleep(20 * time.Millisecond) // cleanup after the deadline
+	*c.finished = true
+	return checks.CheckResult{ID: c.ID(), Title: c.Title(), Passed: true, Message: "done"}, nil
+}
+
+// Run with -race: the runner must not return while the check still runs,
+// or the check would race with the next one over shared state
+func TestRunCheckWaitsForTimedOutCheck(t *testing.T) {
+	scanCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	finished := false
+	results := runCheck(slowCheck{&finished}, checks.Context{Context: scanCtx}, nil)
+
+	if !finished {
+		t.Fatal("runCheck returned while the check was still running")
+	}
+	if len(results) != 1 || results[0].Message != checks.TimedOutResult(slowCheck{}).Message {
+		t.Errorf("results = %+v, want a timed-out result", results)
+	}
+}
+
+func TestRunCheckTimesOutAfterDeadline(t *testing.T) {
+	scanCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	finished := false
+	results := runCheck(slowCheck{&finished}, checks.Context{Context: scanCtx}, nil)
+	if finished {
+		t.Error("a check started after the deadline ran")
+	}
+	if len(results) != 1 || results[0].Passed {
+		t.Errorf("results = %+v, want a timed-out result", results)
+	}
+}
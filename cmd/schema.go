@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/preflightsh/preflight/internal/config"
+	"github.com/preflightsh/preflight/internal/stack"
+	"github.com/spf13/cobra"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print a JSON Schema for preflight.yml",
+	Long: `Print a JSON Schema describing preflight.yml to stdout, for editor
+autocompletion and validation. Save it to a file and reference it from the
+top of your config:
+
+  preflight schema > preflight.schema.json
+  # yaml-language-server: $schema=./preflight.schema.json`,
+	Args: cobra.NoArgs,
+	RunE: runSchema,
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+}
+
+func runSchema(cmd *cobra.Command, args []string) error {
+	schema := config.Schema()
+
+	// The stack list lives outside the config package
+	if properties, ok := schema["properties"].(map[string]interface{}); ok {
+		if stackSchema, ok := properties["stack"].(map[string]interface{}); ok {
+			stackSchema["enum"] = append([]string{"unknown"}, stack.Supported...)
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(schema)
+}
@@ -9,46 +9,98 @@ import (
 	"os/exec"
 	"strings"
 	"time"
+
+	"golang.org/x/term"
 )
 
+// updateFetchTimeout bounds the GitHub request for the latest release
+const updateFetchTimeout = 3 * time.Second
+
 type githubRelease struct {
 	TagName string `json:"tag_name"`
 }
 
-// CheckForUpdates checks if a newer version is available and prompts user to upgrade
-func CheckForUpdates() {
-	// Skip in CI mode or if version is dev
-	if version == "dev" {
+// noUpdateCheck disables the update check (--no-update-check)
+var noUpdateCheck bool
+
+// updateCheckDisabled reports whether the update check is turned off by
+// --no-update-check, PREFLIGHT_NO_UPDATE, or a dev build
+func updateCheckDisabled() bool {
+	if noUpdateCheck || version == "dev" {
+		return true
+	}
+	value := strings.ToLower(os.Getenv("PREFLIGHT_NO_UPDATE"))
+	return value != "" && value != "0" && value != "false"
+}
+
+// StartUpdateCheck fetches the latest version in the background so the
+// request overlaps with the scan. The channel receives the newer version, or
+// "" if there is none or the fetch failed. It is nil when the check is
+// disabled.
+func StartUpdateCheck() <-chan string {
+	if updateCheckDisabled() {
+		return nil
+	}
+	result := make(chan string, 1)
+	go func() {
+		latest, err := fetchLatestVersion()
+		if err != nil || !isNewerVersion(latest, version) {
+			// Silently fail - don't interrupt user workflow for update check failures
+			result <- ""
+			return
+		}
+		result <- latest
+	}()
+	return result
+}
+
+// FinishUpdateCheck reports the result of StartUpdateCheck, waiting at most
+// wait for it. Only an interactive terminal gets the install prompt; CI and
+// piped runs get a one-line notice on stderr instead.
+func FinishUpdateCheck(pending <-chan string, interactive bool, wait time.Duration) {
+	if pending == nil {
 		return
 	}
 
-	latest, err := fetchLatestVersion()
-	if err != nil {
-		// Silently fail - don't interrupt user workflow for update check failures
+	var latest string
+	select {
+	case latest = <-pending:
+	case <-time.After(wait):
+		return
+	}
+	if latest == "" {
 		return
 	}
 
-	if isNewerVersion(latest, version) {
-		fmt.Println()
-		fmt.Printf("📦 A new version of Preflight is available: %s → %s\n", version, latest)
-		fmt.Print("   Install now? [Y/n] ")
+	if !interactive || !term.IsTerminal(int(os.Stdin.Fd())) {
+		fmt.Fprintf(os.Stderr, "📦 Preflight %s is available (you have %s). Upgrade: %s\n", latest, version, getUpgradeCommand())
+		return
+	}
 
-		reader := bufio.NewReader(os.Stdin)
-		response, err := reader.ReadString('\n')
-		if err != nil {
-			// If we can't read input, just show the command
-			fmt.Printf("   Run: %s\n", getUpgradeCommand())
-			return
-		}
+	fmt.Println()
+	fmt.Printf("📦 A new version of Preflight is available: %s → %s\n", version, latest)
+	fmt.Print("   Install now? [Y/n] ")
 
-		response = strings.TrimSpace(strings.ToLower(response))
-		if response == "" || response == "y" || response == "yes" {
-			runUpgrade()
-		} else {
-			fmt.Printf("   To upgrade later: %s\n", getUpgradeCommand())
-		}
-		fmt.Println()
+	reader := bufio.NewReader(os.Stdin)
+	response, err := reader.ReadString('\n')
+	if err != nil {
+		// If we can't read input, just show the command
+		fmt.Printf("   Run: %s\n", getUpgradeCommand())
+		return
 	}
+
+	response = strings.TrimSpace(strings.ToLower(response))
+	if response == "" || response == "y" || response == "yes" {
+		runUpgrade()
+	} else {
+		fmt.Printf("   To upgrade later: %s\n", getUpgradeCommand())
+	}
+	fmt.Println()
+}
+
+// CheckForUpdates checks if a newer version is available and prompts user to upgrade
+func CheckForUpdates() {
+	FinishUpdateCheck(StartUpdateCheck(), true, updateFetchTimeout)
 }
 
 // runUpgrade executes the appropriate upgrade command
@@ -86,7 +138,7 @@ func runUpgrade() {
 }
 
 func fetchLatestVersion() (string, error) {
-	client := &http.Client{Timeout: 3 * time.Second}
+	client := &http.Client{Timeout: updateFetchTimeout}
 
 	resp, err := client.Get("https://api.github.com/repos/preflightsh/preflight/releases/latest")
 	if err != nil {
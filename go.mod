@@ -5,10 +5,13 @@ go 1.25.5
 require (
 	github.com/spf13/cobra v1.10.2
 	golang.org/x/image v0.34.0
+	golang.org/x/term v0.38.0
+	golang.org/x/time v0.14.0
 	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/spf13/pflag v1.0.9 // indirect
+	golang.org/x/sys v0.39.0 // indirect
 )
@@ -0,0 +1,200 @@
+package checks
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// adPixel describes how to find a social ad pixel and its ID
+type adPixel struct {
+	service     string // services key
+	name        string
+	idLabel     string // what the provider calls the ID
+	snippet     []*regexp.Regexp
+	idPattern   *regexp.Regexp // first submatch is the pixel ID
+	suggestions []string
+}
+
+var (
+	metaPixel = adPixel{
+		service: "meta_pixel",
+		name:    "Meta Pixel",
+		idLabel: "Pixel ID",
+		snippet: []*regexp.Regexp{
+			regexp.MustCompile(`connect\.facebook\.net/[a-zA-Z_]+/fbevents\.js`),
+			regexp.MustCompile(`\bfbq\(`),
+			regexp.MustCompile(`facebook\.com/tr\?id=`),
+		},
+		idPattern: regexp.MustCompile(`fbq\(\s*["']init["']\s*,\s*["'](\d{10,20})["']|facebook\.com/tr\?id=(\d{10,20})`),
+		suggestions: []string{
+			"Add the Meta Pixel base code to your main layout's <head>",
+			"Copy it from Events Manager > Data sources > your pixel > Set up",
+		},
+	}
+	tiktokPixel = adPixel{
+		service: "tiktok_pixel",
+		name:    "TikTok Pixel",
+		idLabel: "Pixel ID",
+		snippet: []*regexp.Regexp{
+			regexp.MustCompile(`analytics\.tiktok\.com/i18n/pixel`),
+			regexp.MustCompile(`\bttq\.(load|page|track)\(`),
+		},
+		idPattern: regexp.MustCompile(`ttq\.load\(\s*["']([A-Z0-9]{15,25})["']`),
+		suggestions: []string{
+			"Add the TikTok Pixel base code to your main layout's <head>",
+			"Copy it from TikTok Ads Manager > Assets > Events > Web events",
+		},
+	}
+	linkedInInsight = adPixel{
+		service: "linkedin_insight",
+		name:    "LinkedIn Insight Tag",
+		idLabel: "Partner ID",
+		snippet: []*regexp.Regexp{
+			regexp.MustCompile(`_linkedin_partner_id`),
+			regexp.MustCompile(`snap\.licdn\.com/li\.lms-analytics/insight\.min\.js`),
+		},
+		idPattern: regexp.MustCompile(`_linkedin_partner_id\s*=\s*["']?(\d{4,12})`),
+		suggestions: []string{
+			"Add the LinkedIn Insight Tag to your main layout, before </body>",
+			"Copy it from Campaign Manager > Analyze > Insight Tag",
+		},
+	}
+)
+
+// MetaPixelCheck verifies the Meta (Facebook) Pixel is on the site
+type MetaPixelCheck struct{}
+
+func (c MetaPixelCheck) ID() string {
+	return "meta_pixel"
+}
+
+func (c MetaPixelCheck) Title() string {
+	return "Meta Pixel"
+}
+
+func (c MetaPixelCheck) Run(ctx Context) (CheckResult, error) {
+	return checkAdPixel(ctx, c, metaPixel), nil
+}
+
+// TikTokPixelCheck verifies the TikTok Pixel is on the site
+type TikTokPixelCheck struct{}
+
+func (c TikTokPixelCheck) ID() string {
+	return "tiktok_pixel"
+}
+
+func (c TikTokPixelCheck) Title() string {
+	return "TikTok Pixel"
+}
+
+func (c TikTokPixelCheck) Run(ctx Context) (CheckResult, error) {
+	return checkAdPixel(ctx, c, tiktokPixel), nil
+}
+
+// LinkedInInsightCheck verifies the LinkedIn Insight Tag is on the site
+type LinkedInInsightCheck struct{}
+
+func (c LinkedInInsightCheck) ID() string {
+	return "linkedin_insight"
+}
+
+func (c LinkedInInsightCheck) Title() string {
+	return "LinkedIn Insight Tag"
+}
+
+func (c LinkedInInsightCheck) Run(ctx Context) (CheckResult, error) {
+	return checkAdPixel(ctx, c, linkedInInsight), nil
+}
+
+// checkAdPixel looks for a pixel's snippet in the layouts and live homepage,
+// then in the project's templates and components, and reports the pixel ID
+// it's initialized with
+func checkAdPixel(ctx Context, check Check, pixel adPixel) CheckResult {
+	service, declared := ctx.Config.Services[pixel.service]
+	if !declared || !service.Declared {
+		return CheckResult{
+			ID:       check.ID(),
+			Title:    check.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  pixel.name + " not declared, skipping",
+		}
+	}
+
+	var details []string
+	found := false
+	for _, source := range collectPageSources(ctx) {
+		if !matchesAny(source.Content, pixel.snippet) {
+			continue
+		}
+		found = true
+		if id := pixelID(pixel, source.Content); id != "" {
+			details = append(details, pixel.idLabel+" "+id+" in "+source.Name)
+		} else {
+			details = append(details, "Snippet in "+source.Name+", no inline "+pixel.idLabel+" (set at runtime?)")
+		}
+	}
+
+	if !found {
+		if match := searchForPatternsWithDetails(ctx.RootDir, ctx.Config.Stack, pixel.snippet); match != nil {
+			found = true
+			idMatch := searchForPatternsWithDetails(ctx.RootDir, ctx.Config.Stack, []*regexp.Regexp{pixel.idPattern})
+			var id string
+			if idMatch != nil {
+				content, _ := os.ReadFile(filepath.Join(ctx.RootDir, idMatch.FilePath))
+				id = pixelID(pixel, string(content))
+			}
+			if id != "" {
+				details = append(details, pixel.idLabel+" "+id+" in "+idMatch.FilePath)
+			} else {
+				details = append(details, "Snippet in "+match.FilePath+", no inline "+pixel.idLabel+" (set at runtime?)")
+			}
+		}
+	}
+
+	if found {
+		return CheckResult{
+			ID:       check.ID(),
+			Title:    check.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  pixel.name + " found",
+			Details:  details,
+		}
+	}
+
+	return CheckResult{
+		ID:          check.ID(),
+		Title:       check.Title(),
+		Severity:    SeverityWarn,
+		Passed:      false,
+		Message:     pixel.name + " is declared but not found in templates",
+		Suggestions: pixel.suggestions,
+	}
+}
+
+// pixelID returns the first pixel ID in content, or ""
+func pixelID(pixel adPixel, content string) string {
+	m := pixel.idPattern.FindStringSubmatch(content)
+	if m == nil {
+		return ""
+	}
+	for _, group := range m[1:] {
+		if group != "" {
+			return group
+		}
+	}
+	return ""
+}
+
+// matchesAny reports whether any of the patterns match content
+func matchesAny(content string, patterns []*regexp.Regexp) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(content) {
+			return true
+		}
+	}
+	return false
+}
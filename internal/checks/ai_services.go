@@ -23,6 +23,7 @@ func (c OpenAICheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "OpenAI not declared, skipping",
 		}, nil
 	}
@@ -90,6 +91,7 @@ func (c AnthropicCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Anthropic not declared, skipping",
 		}, nil
 	}
@@ -157,6 +159,7 @@ func (c GoogleAICheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Google AI not declared, skipping",
 		}, nil
 	}
@@ -224,6 +227,7 @@ func (c MistralCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Mistral AI not declared, skipping",
 		}, nil
 	}
@@ -288,6 +292,7 @@ func (c CohereCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Cohere not declared, skipping",
 		}, nil
 	}
@@ -355,6 +360,7 @@ func (c ReplicateCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Replicate not declared, skipping",
 		}, nil
 	}
@@ -422,6 +428,7 @@ func (c HuggingFaceCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Hugging Face not declared, skipping",
 		}, nil
 	}
@@ -489,6 +496,7 @@ func (c GrokCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Grok not declared, skipping",
 		}, nil
 	}
@@ -553,6 +561,7 @@ func (c PerplexityCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Perplexity not declared, skipping",
 		}, nil
 	}
@@ -618,6 +627,7 @@ func (c TogetherAICheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Together AI not declared, skipping",
 		}, nil
 	}
@@ -1,6 +1,7 @@
 package checks
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -25,6 +26,7 @@ func (c FathomCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Fathom not declared, skipping",
 		}, nil
 	}
@@ -80,6 +82,7 @@ func (c GoogleAnalyticsCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Google Analytics not declared, skipping",
 		}, nil
 	}
@@ -97,13 +100,7 @@ func (c GoogleAnalyticsCheck) Run(ctx Context) (CheckResult, error) {
 	found := searchForPatterns(ctx.RootDir, ctx.Config.Stack, patterns)
 
 	if found {
-		return CheckResult{
-			ID:       c.ID(),
-			Title:    c.Title(),
-			Severity: SeverityInfo,
-			Passed:   true,
-			Message:  "Google Analytics configuration found",
-		}, nil
+		return c.checkPropertyType(ctx), nil
 	}
 
 	return CheckResult{
@@ -119,6 +116,71 @@ func (c GoogleAnalyticsCheck) Run(ctx Context) (CheckResult, error) {
 	}, nil
 }
 
+var (
+	ga4MeasurementIDPattern = regexp.MustCompile(`\bG-[A-Z0-9]{6,12}\b`)
+	uaPropertyIDPattern     = regexp.MustCompile(`\bUA-[0-9]{4,10}-[0-9]{1,4}\b`)
+)
+
+// checkPropertyType tells a GA4 measurement ID (G-) from a Universal
+// Analytics property (UA-). Google stopped processing UA data in July 2023,
+// so a UA-only site collects nothing.
+func (c GoogleAnalyticsCheck) checkPropertyType(ctx Context) CheckResult {
+	ga4 := searchForPatternsWithDetails(ctx.RootDir, ctx.Config.Stack, []*regexp.Regexp{ga4MeasurementIDPattern})
+	ua := searchForPatternsWithDetails(ctx.RootDir, ctx.Config.Stack, []*regexp.Regexp{uaPropertyIDPattern})
+
+	var details []string
+	if ga4 != nil {
+		details = append(details, fmt.Sprintf("GA4 measurement ID (G-) in %s:%d", ga4.FilePath, ga4.Line))
+	}
+	if ua != nil {
+		details = append(details, fmt.Sprintf("Universal Analytics property (UA-) in %s:%d", ua.FilePath, ua.Line))
+	}
+
+	switch {
+	case ua != nil && ga4 == nil:
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "Only a Universal Analytics (UA-) property found; UA stopped collecting data in 2023",
+			Suggestions: []string{
+				"Create a GA4 property and replace the UA- ID with its G- measurement ID",
+				"Until then this site records no analytics",
+			},
+			Details: details,
+		}
+	case ga4 != nil && ua != nil:
+		details = append(details, "The UA- property no longer collects data and can be removed")
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  "Google Analytics 4 configured (legacy UA ID also present)",
+			Details:  details,
+		}
+	case ga4 != nil:
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  "Google Analytics 4 configured",
+			Details:  details,
+		}
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "Google Analytics configuration found",
+		Details:  []string{"No G- or UA- ID in source, so the property type is unknown (set through an env var or GTM?)"},
+	}
+}
+
 // RedisCheck verifies Redis connection is configured
 type RedisCheck struct{}
 
@@ -138,6 +200,7 @@ func (c RedisCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Redis not declared, skipping",
 		}, nil
 	}
@@ -223,6 +286,7 @@ func (c SidekiqCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Sidekiq not declared, skipping",
 		}, nil
 	}
@@ -260,7 +324,7 @@ func (c SidekiqCheck) Run(ctx Context) (CheckResult, error) {
 
 // Helper function to search for patterns in layout files
 func searchForPatterns(rootDir, stack string, patterns []*regexp.Regexp) bool {
-	layoutFiles := getLayoutFilesForStack(stack)
+	layoutFiles := scopedPaths(rootDir, getLayoutFilesForStack(stack))
 
 	for _, file := range layoutFiles {
 		path := filepath.Join(rootDir, file)
@@ -276,34 +340,6 @@ func searchForPatterns(rootDir, stack string, patterns []*regexp.Regexp) bool {
 		}
 	}
 
-	// Search in common directories across all stacks
-	searchDirs := []string{
-		".", // root directory
-		// Frontend
-		"src", "app", "components", "pages", "lib",
-		// Monorepo patterns
-		"apps", "packages",
-		// PHP
-		"includes", "partials", "inc",
-		// Templates
-		"templates", "views", "layouts", "_layouts", "_includes",
-		// Public/Static
-		"public", "web", "static", "dist", "www", "_site", "out",
-		// Rails
-		"app/views", "app/views/layouts",
-		// Laravel
-		"resources/views", "resources/views/layouts",
-		// WordPress
-		"wp-content/themes",
-		// Craft CMS
-		"templates/_partials",
-		// Hugo
-		"layouts/_default", "layouts/partials",
-		// SvelteKit
-		"src/routes",
-		// Gatsby
-		"gatsby-browser.js",
-	}
 	extensions := []string{
 		// JavaScript/TypeScript
 		".tsx", ".jsx", ".js", ".ts", ".mjs", ".cjs",
@@ -325,61 +361,22 @@ func searchForPatterns(rootDir, stack string, patterns []*regexp.Regexp) bool {
 		".go", ".tmpl", ".gohtml",
 	}
 
-	for _, dir := range searchDirs {
-		dirPath := filepath.Join(rootDir, dir)
-		if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+	// Search source files across the project; generated code is skipped
+	index := fileIndexFor(rootDir)
+	for _, file := range index.Files("", extensions...) {
+		if hasPathComponent(file.RelPath, "cache", "_generated", ".convex") {
 			continue
 		}
 
-		found := false
-		filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
-			if err != nil || found {
-				return nil
-			}
-
-			// Skip common build/dependency directories
-			baseName := filepath.Base(path)
-			if info.IsDir() {
-				if baseName == "node_modules" || baseName == "vendor" ||
-					baseName == ".git" || baseName == "dist" ||
-					baseName == "build" || baseName == "cache" ||
-					baseName == ".next" || baseName == ".turbo" ||
-					baseName == "coverage" || baseName == "__pycache__" ||
-					baseName == "_generated" || baseName == ".convex" {
-					return filepath.SkipDir
-				}
-				return nil
-			}
-
-			ext := filepath.Ext(path)
-			validExt := false
-			for _, e := range extensions {
-				if ext == e {
-					validExt = true
-					break
-				}
-			}
-			if !validExt {
-				return nil
-			}
-
-			content, err := os.ReadFile(path)
-			if err != nil {
-				return nil
-			}
+		content, err := index.ReadFile(file.Path)
+		if err != nil {
+			continue
+		}
 
-			for _, pattern := range patterns {
-				if pattern.Match(content) {
-					found = true
-					return filepath.SkipAll
-				}
+		for _, pattern := range patterns {
+			if pattern.Match(content) {
+				return true
 			}
-
-			return nil
-		})
-
-		if found {
-			return true
 		}
 	}
 
@@ -389,12 +386,13 @@ func searchForPatterns(rootDir, stack string, patterns []*regexp.Regexp) bool {
 // SearchMatch contains details about a pattern match
 type SearchMatch struct {
 	FilePath string
+	Line     int
 	Pattern  string
 }
 
 // searchForPatternsWithDetails searches for patterns and returns details about the match
 func searchForPatternsWithDetails(rootDir, stack string, patterns []*regexp.Regexp) *SearchMatch {
-	layoutFiles := getLayoutFilesForStack(stack)
+	layoutFiles := scopedPaths(rootDir, getLayoutFilesForStack(stack))
 
 	for _, file := range layoutFiles {
 		path := filepath.Join(rootDir, file)
@@ -411,40 +409,13 @@ func searchForPatternsWithDetails(rootDir, stack string, patterns []*regexp.Rege
 				relPath, _ := filepath.Rel(rootDir, path)
 				return &SearchMatch{
 					FilePath: relPath,
+					Line:     lineNumber(string(content), pattern),
 					Pattern:  pattern.String(),
 				}
 			}
 		}
 	}
 
-	// Search in common directories across all stacks
-	searchDirs := []string{
-		".", // root directory
-		// Frontend
-		"src", "app", "components", "pages", "lib",
-		// Monorepo patterns
-		"apps", "packages",
-		// PHP
-		"includes", "partials", "inc",
-		// Templates
-		"templates", "views", "layouts", "_layouts", "_includes",
-		// Public/Static
-		"public", "web", "static", "dist", "www", "_site", "out",
-		// Rails
-		"app/views", "app/views/layouts",
-		// Laravel
-		"resources/views", "resources/views/layouts",
-		// WordPress
-		"wp-content/themes",
-		// Craft CMS
-		"templates/_partials",
-		// Hugo
-		"layouts/_default", "layouts/partials",
-		// SvelteKit
-		"src/routes",
-		// Gatsby
-		"gatsby-browser.js",
-	}
 	extensions := []string{
 		// JavaScript/TypeScript
 		".tsx", ".jsx", ".js", ".ts", ".mjs", ".cjs",
@@ -466,68 +437,29 @@ func searchForPatternsWithDetails(rootDir, stack string, patterns []*regexp.Rege
 		".go", ".tmpl", ".gohtml",
 	}
 
-	var result *SearchMatch
-	for _, dir := range searchDirs {
-		dirPath := filepath.Join(rootDir, dir)
-		if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+	// Search source files across the project; generated code is skipped
+	index := fileIndexFor(rootDir)
+	for _, file := range index.Files("", extensions...) {
+		if hasPathComponent(file.RelPath, "cache", "_generated", ".convex") {
 			continue
 		}
 
-		filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
-			if err != nil || result != nil {
-				return nil
-			}
-
-			// Skip common build/dependency directories
-			baseName := filepath.Base(path)
-			if info.IsDir() {
-				if baseName == "node_modules" || baseName == "vendor" ||
-					baseName == ".git" || baseName == "dist" ||
-					baseName == "build" || baseName == "cache" ||
-					baseName == ".next" || baseName == ".turbo" ||
-					baseName == "coverage" || baseName == "__pycache__" ||
-					baseName == "_generated" || baseName == ".convex" {
-					return filepath.SkipDir
-				}
-				return nil
-			}
-
-			ext := filepath.Ext(path)
-			validExt := false
-			for _, e := range extensions {
-				if ext == e {
-					validExt = true
-					break
-				}
-			}
-			if !validExt {
-				return nil
-			}
+		content, err := index.ReadFile(file.Path)
+		if err != nil {
+			continue
+		}
 
-			content, err := os.ReadFile(path)
-			if err != nil {
-				return nil
-			}
+		// Strip comments to avoid false positives on commented-out code
+		contentStr := stripCommentsForSearch(string(content))
 
-			// Strip comments to avoid false positives on commented-out code
-			contentStr := stripCommentsForSearch(string(content))
-
-			for _, pattern := range patterns {
-				if pattern.MatchString(contentStr) {
-					relPath, _ := filepath.Rel(rootDir, path)
-					result = &SearchMatch{
-						FilePath: relPath,
-						Pattern:  pattern.String(),
-					}
-					return filepath.SkipAll
+		for _, pattern := range patterns {
+			if pattern.MatchString(contentStr) {
+				return &SearchMatch{
+					FilePath: filepath.FromSlash(file.RelPath),
+					Line:     lineNumber(string(content), pattern),
+					Pattern:  pattern.String(),
 				}
 			}
-
-			return nil
-		})
-
-		if result != nil {
-			return result
 		}
 	}
 
@@ -0,0 +1,73 @@
+package checks
+
+import (
+	"fmt"
+	"strings"
+)
+
+// analyticsServices are the service checks for analytics providers
+var analyticsServices = []string{"plausible", "fathom", "google_analytics", "fullres", "datafast", "posthog", "mixpanel", "amplitude", "segment", "hotjar"}
+
+// AnalyticsConfiguredCheck notes when no analytics provider is set up. A
+// launch with no analytics is usually an oversight, but not always, so it
+// is informational unless checks.analytics.severity is "warn". Like
+// ErrorMonitoringCheck it reads the service checks' results.
+type AnalyticsConfiguredCheck struct{}
+
+func (c AnalyticsConfiguredCheck) ID() string {
+	return "analytics"
+}
+
+func (c AnalyticsConfiguredCheck) Title() string {
+	return "Analytics"
+}
+
+func (c AnalyticsConfiguredCheck) Aggregates() bool {
+	return true
+}
+
+func (c AnalyticsConfiguredCheck) Run(ctx Context) (CheckResult, error) {
+	var working, details []string
+	for _, r := range ctx.Results {
+		if !contains(analyticsServices, r.ID) || r.Skipped {
+			continue
+		}
+		if r.Passed {
+			working = append(working, r.Title)
+		}
+		details = append(details, fmt.Sprintf("%s: %s", r.Title, r.Message))
+	}
+
+	if len(working) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  "Analytics set up with " + strings.Join(working, ", "),
+			Details:  details,
+		}, nil
+	}
+
+	severity := SeverityInfo
+	if cfg := ctx.Config.Checks.Analytics; cfg != nil && strings.EqualFold(cfg.Severity, "warn") {
+		severity = SeverityWarn
+	}
+
+	message := "No analytics provider found"
+	if len(details) > 0 {
+		message = "No analytics provider is fully set up"
+	}
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: severity,
+		Passed:   false,
+		Message:  message,
+		Suggestions: []string{
+			"Add analytics (e.g. Plausible, Fathom, PostHog, or Google Analytics) if you want launch traffic numbers",
+			"Declare it under services in preflight.yml (or rerun 'preflight init') so preflight verifies it",
+		},
+		Details: details,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package checks
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// analyticsProvider describes how to spot one provider's snippet and its IDs
+type analyticsProvider struct {
+	name    string
+	script  *regexp.Regexp // loader script; more than one per page is a duplicate
+	idMatch *regexp.Regexp // first capture group is the property/site ID
+}
+
+var analyticsProviders = []analyticsProvider{
+	{
+		name:    "Google Analytics",
+		script:  regexp.MustCompile(`googletagmanager\.com/gtag/js`),
+		idMatch: regexp.MustCompile(`\b(G-[A-Z0-9]{6,}|UA-[0-9]{4,}-[0-9]+)\b`),
+	},
+	{
+		name:    "Google Tag Manager",
+		script:  regexp.MustCompile(`googletagmanager\.com/gtm\.js`),
+		idMatch: regexp.MustCompile(`\b(GTM-[A-Z0-9]{4,})\b`),
+	},
+	{
+		name:    "Plausible",
+		script:  regexp.MustCompile(`plausible\.io/js/[a-z.\-]*\.js`),
+		idMatch: regexp.MustCompile(`data-domain=["']([^"']+)["']`),
+	},
+	{
+		name:    "Fathom",
+		script:  regexp.MustCompile(`cdn\.usefathom\.com/script\.js`),
+		idMatch: regexp.MustCompile(`data-site=["']([A-Z0-9]+)["']`),
+	},
+	{
+		name:    "Facebook Pixel",
+		script:  regexp.MustCompile(`connect\.facebook\.net/[a-zA-Z_]+/fbevents\.js`),
+		idMatch: regexp.MustCompile(`fbq\(\s*["']init["']\s*,\s*["']([0-9]+)["']`),
+	},
+	{
+		name:    "Segment",
+		script:  regexp.MustCompile(`cdn\.segment\.com/analytics\.js`),
+		idMatch: regexp.MustCompile(`analytics\.load\(\s*["']([A-Za-z0-9]+)["']`),
+	},
+	{
+		name:    "Hotjar",
+		script:  regexp.MustCompile(`static\.hotjar\.com/c/hotjar-`),
+		idMatch: regexp.MustCompile(`hjid\s*:\s*([0-9]+)`),
+	},
+}
+
+// DuplicateAnalyticsCheck detects the same analytics provider loaded more
+// than once, which double-counts pageviews
+type DuplicateAnalyticsCheck struct{}
+
+func (c DuplicateAnalyticsCheck) ID() string {
+	return "duplicate_analytics"
+}
+
+func (c DuplicateAnalyticsCheck) Title() string {
+	return "Duplicate analytics"
+}
+
+func (c DuplicateAnalyticsCheck) RunsFromPage() bool {
+	return true
+}
+
+func (c DuplicateAnalyticsCheck) Run(ctx Context) (CheckResult, error) {
+	sources := collectPageSources(ctx)
+	if len(sources) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No layout file or site URL found, skipping",
+		}, nil
+	}
+
+	var conflicts []string
+	var details []string
+	ids := make(map[string]map[string]bool)
+
+	for _, provider := range analyticsProviders {
+		ids[provider.name] = make(map[string]bool)
+		for _, source := range sources {
+			if n := len(provider.script.FindAllString(source.Content, -1)); n > 1 {
+				if !contains(conflicts, provider.name) {
+					conflicts = append(conflicts, provider.name)
+				}
+				details = append(details, fmt.Sprintf("%s: script loaded %d times in %s", provider.name, n, source.Name))
+			}
+			for _, m := range provider.idMatch.FindAllStringSubmatch(source.Content, -1) {
+				ids[provider.name][m[1]] = true
+			}
+		}
+
+		if len(ids[provider.name]) > 1 {
+			if !contains(conflicts, provider.name) {
+				conflicts = append(conflicts, provider.name)
+			}
+			details = append(details, fmt.Sprintf("%s: multiple IDs %s", provider.name, strings.Join(sortedKeys(ids[provider.name]), ", ")))
+		}
+	}
+
+	// A GTM container usually loads GA itself; a direct gtag.js alongside it double-counts
+	if len(ids["Google Tag Manager"]) > 0 && hasAnySource(sources, analyticsProviders[0].script) {
+		conflicts = append(conflicts, "Google Analytics + Tag Manager")
+		details = append(details, fmt.Sprintf("Google Analytics loaded directly via gtag.js and GTM container %s is also present",
+			strings.Join(sortedKeys(ids["Google Tag Manager"]), ", ")))
+	}
+
+	if len(conflicts) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "Possible duplicate tracking: " + strings.Join(conflicts, ", "),
+			Suggestions: []string{
+				"Load each analytics provider once, from a single layout or tag manager",
+				"If GA is configured inside GTM, remove the direct gtag.js snippet",
+			},
+			Details: details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "No duplicate analytics snippets found",
+	}, nil
+}
+
+func hasAnySource(sources []pageSource, pattern *regexp.Regexp) bool {
+	for _, source := range sources {
+		if pattern.MatchString(source.Content) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
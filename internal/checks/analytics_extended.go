@@ -23,6 +23,7 @@ func (c FullresCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Fullres not declared, skipping",
 		}, nil
 	}
@@ -79,6 +80,7 @@ func (c DatafastCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Datafa.st not declared, skipping",
 		}, nil
 	}
@@ -132,6 +134,7 @@ func (c PostHogCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "PostHog not declared, skipping",
 		}, nil
 	}
@@ -193,6 +196,7 @@ func (c MixpanelCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Mixpanel not declared, skipping",
 		}, nil
 	}
@@ -248,6 +252,7 @@ func (c HotjarCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Hotjar not declared, skipping",
 		}, nil
 	}
@@ -303,6 +308,7 @@ func (c AmplitudeCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Amplitude not declared, skipping",
 		}, nil
 	}
@@ -359,6 +365,7 @@ func (c SegmentCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Segment not declared, skipping",
 		}, nil
 	}
@@ -23,6 +23,7 @@ func (c Auth0Check) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Auth0 not declared, skipping",
 		}, nil
 	}
@@ -88,6 +89,7 @@ func (c ClerkCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Clerk not declared, skipping",
 		}, nil
 	}
@@ -152,6 +154,7 @@ func (c WorkOSCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "WorkOS not declared, skipping",
 		}, nil
 	}
@@ -215,6 +218,7 @@ func (c FirebaseCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Firebase not declared, skipping",
 		}, nil
 	}
@@ -282,6 +286,7 @@ func (c SupabaseCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Supabase not declared, skipping",
 		}, nil
 	}
@@ -0,0 +1,94 @@
+package checks
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// BasicAuthCheck catches staging password protection left on the
+// production URL. It only ever requests production, without credentials.
+type BasicAuthCheck struct{}
+
+func (c BasicAuthCheck) ID() string {
+	return "basic_auth"
+}
+
+func (c BasicAuthCheck) Title() string {
+	return "Password protection"
+}
+
+func (c BasicAuthCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c BasicAuthCheck) DependsOn() []string {
+	return siteDependencies
+}
+
+func (c BasicAuthCheck) Run(ctx Context) (CheckResult, error) {
+	if ctx.Config.URLs.Production == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No production URL configured, skipping",
+		}, nil
+	}
+
+	// Credentials embedded in the URL would be sent by the client; drop them
+	prodURL := ctx.Config.URLs.Production
+	if parsed, err := url.Parse(prodURL); err == nil && parsed.User != nil {
+		parsed.User = nil
+		prodURL = parsed.String()
+	}
+	if !strings.HasPrefix(prodURL, "http://") && !strings.HasPrefix(prodURL, "https://") {
+		prodURL = "https://" + prodURL
+	}
+
+	resp, err := doGet(ctx.Client, prodURL)
+	if err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "Could not reach production URL, skipping",
+		}, nil
+	}
+	resp.Body.Close()
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	if resp.StatusCode == 401 {
+		scheme := strings.SplitN(strings.TrimSpace(challenge), " ", 2)[0]
+		if strings.EqualFold(scheme, "basic") || strings.EqualFold(scheme, "digest") {
+			return CheckResult{
+				ID:       c.ID(),
+				Title:    c.Title(),
+				Severity: SeverityError,
+				Passed:   false,
+				Message:  "Production homepage asks for a password (" + scheme + " auth)",
+				Suggestions: []string{
+					"Remove the staging password (htpasswd, host password protection) from the production site",
+					"Keep access protection on the staging URL only",
+				},
+				Details: []string{
+					fmt.Sprintf("%s returned 401", prodURL),
+					"WWW-Authenticate: " + challenge,
+				},
+			}, nil
+		}
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "Production homepage doesn't require a password",
+		Details:  []string{fmt.Sprintf("%s returned %d", prodURL, resp.StatusCode)},
+	}, nil
+}
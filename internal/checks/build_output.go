@@ -0,0 +1,141 @@
+package checks
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// BuildOutputCheck flags framework build output (.next/, dist/, ...) that is
+// committed to git, or isn't gitignored and so could be
+type BuildOutputCheck struct{}
+
+func (c BuildOutputCheck) ID() string {
+	return "build_output"
+}
+
+func (c BuildOutputCheck) Title() string {
+	return "Build output in git"
+}
+
+// frameworkBuildDirs are always build output
+var frameworkBuildDirs = []string{".next", ".nuxt"}
+
+// bundlerBuildDirs are build output in a JavaScript project, but may be
+// source elsewhere (e.g. a Go build/ packaging dir)
+var bundlerBuildDirs = []string{"dist", "build", "out"}
+
+func (c BuildOutputCheck) Run(ctx Context) (CheckResult, error) {
+	_, gitErr := exec.LookPath("git")
+	useGit := gitErr == nil && isGitWorkTree(ctx)
+	gitignore := parseIgnoreFile(filepath.Join(ctx.RootDir, ".gitignore"))
+
+	var problems, committed, details []string
+	found := 0
+	for _, dir := range scopedPaths(ctx.RootDir, []string{"."}) {
+		dir = filepath.Clean(dir)
+		candidates := frameworkBuildDirs
+		if fileExists(ctx.RootDir, filepath.Join(dir, "package.json")) {
+			candidates = append(append([]string{}, frameworkBuildDirs...), bundlerBuildDirs...)
+		}
+
+		for _, name := range candidates {
+			rel := filepath.Join(dir, name)
+			if info, err := os.Stat(filepath.Join(ctx.RootDir, rel)); err != nil || !info.IsDir() {
+				continue
+			}
+			found++
+			label := filepath.ToSlash(rel) + "/"
+
+			if useGit {
+				if tracked := gitTrackedFiles(ctx, rel); tracked > 0 {
+					problems = append(problems, fmt.Sprintf("%s is committed (%d files)", label, tracked))
+					committed = append(committed, label)
+					details = append(details, fmt.Sprintf("%s: %d tracked files", label, tracked))
+					continue
+				}
+			}
+
+			var ignored bool
+			if useGit {
+				ignored = gitIgnores(ctx, rel)
+			} else {
+				ignored = matchIgnoreRules(gitignore, filepath.ToSlash(rel), true)
+			}
+			if ignored {
+				details = append(details, label+": gitignored")
+			} else {
+				problems = append(problems, label+" is not gitignored")
+				details = append(details, label+": not gitignored")
+			}
+		}
+	}
+
+	if found == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No build output directories found, skipping",
+		}, nil
+	}
+
+	if len(problems) > 0 {
+		suggestions := []string{"Add build output directories to .gitignore"}
+		if len(committed) > 0 {
+			suggestions = append(suggestions, fmt.Sprintf("Remove them from git without deleting them: git rm -r --cached %s", strings.Join(committed, " ")))
+		}
+		suggestions = append(suggestions, "Let your host or CI build the site from source")
+		return CheckResult{
+			ID:          c.ID(),
+			Title:       c.Title(),
+			Severity:    SeverityWarn,
+			Passed:      false,
+			Message:     strings.Join(problems, ", "),
+			Suggestions: suggestions,
+			Details:     details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "Build output is gitignored",
+		Details:  details,
+	}, nil
+}
+
+// isGitWorkTree reports whether the project is inside a git work tree. The
+// git helpers are killed when the scan deadline runs out.
+func isGitWorkTree(ctx Context) bool {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--is-inside-work-tree")
+	cmd.Dir = ctx.RootDir
+	out, err := cmd.Output()
+	return err == nil && strings.TrimSpace(string(out)) == "true"
+}
+
+// gitTrackedFiles counts the files under rel that git tracks
+func gitTrackedFiles(ctx Context, rel string) int {
+	cmd := exec.CommandContext(ctx, "git", "ls-files", "-z", "--", rel)
+	cmd.Dir = ctx.RootDir
+	out, err := cmd.Output()
+	if err != nil {
+		return 0
+	}
+	return bytes.Count(out, []byte{0})
+}
+
+// gitIgnores reports whether git ignores the directory rel, honoring nested
+// .gitignore files and global excludes
+func gitIgnores(ctx Context, rel string) bool {
+	cmd := exec.CommandContext(ctx, "git", "check-ignore", "-q", "--", filepath.ToSlash(rel)+"/")
+	cmd.Dir = ctx.RootDir
+	return cmd.Run() == nil
+}
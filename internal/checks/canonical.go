@@ -32,6 +32,7 @@ func (c CanonicalURLCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "No layout file found, skipping",
 		}, nil
 	}
@@ -0,0 +1,151 @@
+package checks
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// CanonicalHostCheck cross-references the homepage's rel=canonical host with
+// the host the site actually serves from after redirects. A canonical on
+// www while the apex serves without redirecting (or the reverse) points
+// search engines at a URL visitors never land on, which the canonical and
+// www_redirect checks each miss on their own.
+type CanonicalHostCheck struct{}
+
+func (c CanonicalHostCheck) ID() string {
+	return "canonical_host"
+}
+
+func (c CanonicalHostCheck) Title() string {
+	return "Canonical host"
+}
+
+func (c CanonicalHostCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c CanonicalHostCheck) DependsOn() []string {
+	return siteDependencies
+}
+
+func (c CanonicalHostCheck) Run(ctx Context) (CheckResult, error) {
+	prodURL := ctx.Config.URLs.Production
+	if prodURL == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No production URL configured",
+		}, nil
+	}
+
+	if isLocalURL(extractHost(prodURL)) {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "Skipped for local URL",
+		}, nil
+	}
+
+	resp, err := doGet(ctx.Client, prodURL)
+	if err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "Production URL unreachable, skipping",
+			Details:  []string{fmt.Sprintf("%s: %v", prodURL, err)},
+		}, nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+	resp.Body.Close()
+	served := resp.Request.URL
+
+	href := findCanonical([]byte(htmlCommentPattern.ReplaceAllString(string(body), "")))
+	if href == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No canonical link on the homepage, skipping",
+		}, nil
+	}
+	canonical, err := served.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("Canonical URL %q is not a valid URL", href),
+		}, nil
+	}
+
+	servedHost := strings.ToLower(served.Hostname())
+	canonicalHost := strings.ToLower(canonical.Hostname())
+	details := []string{
+		"Canonical: " + canonical.String(),
+		"Served at: " + served.String(),
+	}
+
+	// A canonical on another domain is deliberate (syndication, migrations)
+	if strings.TrimPrefix(canonicalHost, "www.") != strings.TrimPrefix(servedHost, "www.") {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  fmt.Sprintf("Canonical points to another domain (%s), skipping", canonicalHost),
+			Details:  details,
+		}, nil
+	}
+
+	if canonicalHost == servedHost {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  fmt.Sprintf("Canonical matches the served host (%s)", servedHost),
+			Details:  details,
+		}, nil
+	}
+
+	// See where the canonical's host ends up, to tell "serves both without
+	// redirecting" from "redirects away from its own canonical"
+	canonicalFinal, err := getFinalURL(ctx.Client, canonical.Scheme + "://" + canonical.Host)
+	if err != nil {
+		details = append(details, fmt.Sprintf("%s: %v", canonicalHost, err))
+	} else {
+		details = append(details, fmt.Sprintf("%s → %s", canonicalHost, canonicalFinal))
+	}
+
+	message := fmt.Sprintf("Canonical says %s but the site serves %s without redirecting", canonicalHost, servedHost)
+	if err == nil && extractHost(canonicalFinal) == servedHost {
+		message = fmt.Sprintf("Canonical says %s but it redirects to %s", canonicalHost, servedHost)
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityWarn,
+		Passed:   false,
+		Message:  message,
+		Suggestions: []string{
+			fmt.Sprintf("Point rel=canonical at the host you enforce (%s), or redirect %s to %s", servedHost, servedHost, canonicalHost),
+			"Search engines may ignore a canonical that disagrees with the redirects",
+		},
+		Details: details,
+	}, nil
+}
@@ -0,0 +1,140 @@
+package checks
+
+import (
+	"fmt"
+	"strings"
+)
+
+// coreCategories maps core check IDs to the category they're shown and
+// listed under
+var coreCategories = map[string]string{
+	"envParity":           "ENV",
+	"healthEndpoint":      "HEALTH",
+	"seoMeta":             "SEO",
+	"ogTwitter":           "SOCIAL",
+	"securityHeaders":     "SECURITY",
+	"ssl":                 "SSL",
+	"secrets":             "SECRETS",
+	"favicon":             "ICONS",
+	"robotsTxt":           "FILES",
+	"sitemap":             "FILES",
+	"llmsTxt":             "FILES",
+	"adsTxt":              "FILES",
+	"humansTxt":           "FILES",
+	"license":             "LICENSE",
+	"vulnerability":       "DEPS",
+	"indexNow":            "INDEXNOW",
+	"canonical":           "SEO",
+	"viewport":            "MOBILE",
+	"lang":                "LANG",
+	"error_pages":         "PAGES",
+	"debug_statements":    "DEBUG",
+	"structured_data":     "SEO",
+	"image_optimization":  "PERF",
+	"email_auth":          "EMAIL",
+	"www_redirect":        "INFRA",
+	"legal_pages":         "LEGAL",
+	"http_version":        "PERF",
+	"cors":                "SECURITY",
+	"open_redirect":       "SECURITY",
+	"csp":                 "SECURITY",
+	"sri":                 "SECURITY",
+	"duplicate_analytics": "ANALYTICS",
+	"tracking_consent":    "LEGAL",
+	"trailing_slash":      "INFRA",
+	"hreflang":            "LANG",
+	"dockerfile":          "INFRA",
+	"docker_compose":      "INFRA",
+	"hardcoded_urls":      "DEBUG",
+	"todo_markers":        "DEBUG",
+	"outdated_deps":       "DEPS",
+	"robots_meta":         "SEO",
+	"basic_auth":          "SECURITY",
+	"duplicate_meta":      "SEO",
+	"lockfile":            "DEPS",
+	"canonical_host":      "SEO",
+	"build_output":        "INFRA",
+	"prod_config":         "ENV",
+	"stripe_keys":         "SECRETS",
+	"error_monitoring":    "ERRORS",
+	"analytics":           "ANALYTICS",
+	"reachable":           "INFRA",
+	"minified_assets":     "PERF",
+	"duplicate_page_meta": "SEO",
+}
+
+// serviceCategories maps the checks for declared services to their
+// category. These are grouped apart from the core checks in scan output.
+var serviceCategories = map[string]string{
+	// Payments
+	"stripe": "PAYMENTS", "paypal": "PAYMENTS", "braintree": "PAYMENTS", "paddle": "PAYMENTS", "lemonsqueezy": "PAYMENTS",
+	// Error Tracking
+	"sentry": "ERRORS", "bugsnag": "ERRORS", "rollbar": "ERRORS", "honeybadger": "ERRORS",
+	"datadog": "ERRORS", "newrelic": "ERRORS", "logrocket": "ERRORS",
+	// Email
+	"postmark": "EMAIL", "sendgrid": "EMAIL", "mailgun": "EMAIL", "aws_ses": "EMAIL", "resend": "EMAIL",
+	"mailchimp": "EMAIL", "convertkit": "EMAIL", "beehiiv": "EMAIL", "aweber": "EMAIL",
+	"activecampaign": "EMAIL", "campaignmonitor": "EMAIL", "drip": "EMAIL", "klaviyo": "EMAIL", "buttondown": "EMAIL",
+	// Analytics
+	"plausible": "ANALYTICS", "fathom": "ANALYTICS", "google_analytics": "ANALYTICS", "fullres": "ANALYTICS", "datafast": "ANALYTICS",
+	"posthog": "ANALYTICS", "mixpanel": "ANALYTICS", "amplitude": "ANALYTICS", "segment": "ANALYTICS", "hotjar": "ANALYTICS",
+	"meta_pixel": "ANALYTICS", "tiktok_pixel": "ANALYTICS", "linkedin_insight": "ANALYTICS",
+	// Auth
+	"auth0": "AUTH", "clerk": "AUTH", "workos": "AUTH", "firebase": "AUTH", "supabase": "AUTH",
+	// Communication
+	"twilio": "NOTIFY", "slack": "NOTIFY", "discord": "NOTIFY", "intercom": "CHAT", "crisp": "CHAT",
+	// Infrastructure
+	"redis": "INFRA", "sidekiq": "JOBS", "rabbitmq": "JOBS", "elasticsearch": "SEARCH", "convex": "INFRA",
+	// Storage & CDN
+	"aws_s3": "STORAGE", "cloudinary": "STORAGE", "cloudflare": "INFRA",
+	// Search
+	"algolia": "SEARCH",
+	// AI
+	"openai": "AI", "anthropic": "AI", "google_ai": "AI", "mistral": "AI", "cohere": "AI",
+	"replicate": "AI", "huggingface": "AI", "grok": "AI", "perplexity": "AI", "together_ai": "AI",
+	// Cookie Consent
+	"cookieconsent": "LEGAL", "cookiebot": "LEGAL", "onetrust": "LEGAL", "termly": "LEGAL", "cookieyes": "LEGAL", "iubenda": "LEGAL",
+	// SEO
+	"indexNow": "INDEXNOW",
+}
+
+// Every registered check needs a category, or it would be listed and shown
+// under its own upper-cased ID. Failing at startup catches a new check that
+// was added to Registry but not to either map.
+func init() {
+	if missing := uncategorized(); len(missing) > 0 {
+		panic(fmt.Sprintf("checks: no category for %s; add them to coreCategories or serviceCategories", strings.Join(missing, ", ")))
+	}
+}
+
+// uncategorized lists registered check IDs that are in neither category map
+func uncategorized() []string {
+	var missing []string
+	for _, check := range Registry {
+		_, core := coreCategories[check.ID()]
+		_, service := serviceCategories[check.ID()]
+		if !core && !service {
+			missing = append(missing, check.ID())
+		}
+	}
+	return missing
+}
+
+// Category returns the display category of a check, such as SEO or
+// SECURITY. Checks without one are their own category, the upper-cased ID.
+func Category(id string) string {
+	if category, ok := serviceCategories[id]; ok {
+		return category
+	}
+	if category, ok := coreCategories[id]; ok {
+		return category
+	}
+	return strings.ToUpper(id)
+}
+
+// IsService reports whether a check verifies a declared service rather than
+// the project itself
+func IsService(id string) bool {
+	_, ok := serviceCategories[id]
+	return ok
+}
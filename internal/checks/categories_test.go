@@ -0,0 +1,31 @@
+package checks
+
+import "testing"
+
+func TestEveryCheckHasCategory(t *testing.T) {
+	if missing := uncategorized(); len(missing) > 0 {
+		t.Errorf("checks without a category: %v", missing)
+	}
+}
+
+func TestCategory(t *testing.T) {
+	cases := []struct {
+		id       string
+		category string
+		service  bool
+	}{
+		{"seoMeta", "SEO", false},
+		{"stripe_keys", "SECRETS", false},
+		{"stripe", "PAYMENTS", true},
+		{"meta_pixel", "ANALYTICS", true},
+		{"not_a_check", "NOT_A_CHECK", false},
+	}
+	for _, tc := range cases {
+		if got := Category(tc.id); got != tc.category {
+			t.Errorf("Category(%q) = %q, want %q", tc.id, got, tc.category)
+		}
+		if got := IsService(tc.id); got != tc.service {
+			t.Errorf("IsService(%q) = %v, want %v", tc.id, got, tc.service)
+		}
+	}
+}
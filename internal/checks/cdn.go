@@ -0,0 +1,102 @@
+package checks
+
+import (
+	"net/http"
+	"strings"
+)
+
+// cdnProvider describes a CDN or edge platform that may front a site and
+// add or strip response headers on the way through
+type cdnProvider struct {
+	Name     string
+	Headers  []string // any of these response headers marks the CDN
+	Server   string   // lowercase substring of the Server or Via header
+	ServedBy string   // lowercase prefix of the X-Served-By header
+	Defaults []string // headers the edge adds unless turned off
+	Settings string   // where headers are configured at the edge
+	HSTS     string   // where HSTS is configured, if not in Settings
+}
+
+var cdnProviders = []cdnProvider{
+	{
+		Name:     "Cloudflare",
+		Headers:  []string{"Cf-Ray"},
+		Server:   "cloudflare",
+		Settings: "a Transform Rule (Rules → Transform Rules → Modify Response Header)",
+		HSTS:     "SSL/TLS → Edge Certificates → HTTP Strict Transport Security (HSTS)",
+	},
+	{
+		Name:     "Vercel",
+		Headers:  []string{"X-Vercel-Id"},
+		Server:   "vercel",
+		Defaults: []string{"Strict-Transport-Security"},
+		Settings: "the headers section of vercel.json or next.config.js",
+	},
+	{
+		Name:     "Netlify",
+		Headers:  []string{"X-Nf-Request-Id"},
+		Server:   "netlify",
+		Defaults: []string{"Strict-Transport-Security"},
+		Settings: "a _headers file or netlify.toml",
+	},
+	{
+		Name:     "CloudFront",
+		Headers:  []string{"X-Amz-Cf-Id", "X-Amz-Cf-Pop"},
+		Server:   "cloudfront",
+		Settings: "a CloudFront response headers policy",
+	},
+	{
+		Name:     "Fastly",
+		Headers:  []string{"X-Fastly-Request-Id"},
+		Server:   "varnish",
+		ServedBy: "cache-", // cache node names, e.g. cache-lhr7321-LHR
+		Settings: "VCL or a Fastly response header rule",
+	},
+	{
+		Name:     "Akamai",
+		Headers:  []string{"X-Akamai-Transformed", "Akamai-Grn"},
+		Server:   "akamaighost",
+		Settings: "an Akamai Property Manager rule",
+	},
+}
+
+// detectCDN identifies the CDN that served a response from its Server, Via,
+// and provider-specific headers, returning the provider and the header that
+// gave it away
+func detectCDN(h http.Header) (*cdnProvider, string) {
+	server := strings.ToLower(h.Get("Server"))
+	via := strings.ToLower(h.Get("Via"))
+	servedBy := strings.ToLower(h.Get("X-Served-By"))
+	for i := range cdnProviders {
+		p := &cdnProviders[i]
+		for _, header := range p.Headers {
+			if h.Get(header) != "" {
+				return p, header
+			}
+		}
+		if strings.Contains(server, p.Server) {
+			return p, "Server: " + h.Get("Server")
+		}
+		if strings.Contains(via, p.Server) {
+			return p, "Via: " + h.Get("Via")
+		}
+		if p.ServedBy != "" && strings.HasPrefix(servedBy, p.ServedBy) {
+			return p, "X-Served-By: " + h.Get("X-Served-By")
+		}
+	}
+	return nil, ""
+}
+
+// edgeSetting returns where header can be set on this CDN
+func (p *cdnProvider) edgeSetting(header string) string {
+	if header == "Strict-Transport-Security" && p.HSTS != "" {
+		return p.HSTS
+	}
+	return p.Settings
+}
+
+// setsByDefault reports whether the edge adds header on its own, so its
+// presence says nothing about the origin's configuration
+func (p *cdnProvider) setsByDefault(header string) bool {
+	return contains(p.Defaults, header)
+}
@@ -1,8 +1,15 @@
 package checks
 
 import (
+	"context"
+	"errors"
+	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/preflightsh/preflight/internal/config"
@@ -17,20 +24,71 @@ const (
 )
 
 type CheckResult struct {
-	ID          string   `json:"id"`
-	Title       string   `json:"title"`
-	Severity    Severity `json:"severity"`
-	Passed      bool     `json:"passed"`
-	Message     string   `json:"message"`
-	Suggestions []string `json:"suggestions,omitempty"`
-	Details     []string `json:"details,omitempty"` // Verbose output details
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Severity    Severity   `json:"severity"`
+	Passed      bool       `json:"passed"`
+	Skipped     bool       `json:"skipped,omitempty"` // Passed without really running (no URL, not applicable)
+	Errored     bool       `json:"errored,omitempty"` // Run returned an error: the check broke, so nothing was verified
+	Message     string     `json:"message"`
+	Suggestions []string   `json:"suggestions,omitempty"`
+	Details     []string   `json:"details,omitempty"`   // Verbose output details
+	Locations   []Location `json:"locations,omitempty"` // Source locations of findings
+}
+
+// Location points at a finding in a project file
+type Location struct {
+	File    string `json:"file"`
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// String formats the location as file:line, followed by the message if any
+func (l Location) String() string {
+	s := l.File
+	if l.Line > 0 {
+		s += ":" + strconv.Itoa(l.Line)
+	}
+	if l.Message != "" {
+		s += " - " + l.Message
+	}
+	return s
+}
+
+// lineNumber returns the 1-based line of the first match of pattern in
+// content, or 0 if there is no match
+func lineNumber(content string, pattern *regexp.Regexp) int {
+	loc := pattern.FindStringIndex(content)
+	if loc == nil {
+		return 0
+	}
+	return strings.Count(content[:loc[0]], "\n") + 1
 }
 
 type Context struct {
+	// The scan's deadline (--deadline); cancelled when it runs out. Pass ctx
+	// to DNS lookups, dials, and exec calls, and stop walks when ctx.Err() is
+	// set, so a check returns instead of stalling the scan.
+	context.Context
+
 	RootDir string
 	Config  *config.PreflightConfig
-	Client  *http.Client
+	Client  *http.Client // nil in offline mode; requests then fail with errOffline
 	Verbose bool
+	Files   *FileIndex // Shared project file listing; nil falls back to one built on demand
+
+	// NoSource is set by scan --url: RootDir is an empty directory and only
+	// checks that work from the live site run
+	NoSource bool
+
+	// Results holds the results of every other check. The runner fills it in
+	// for AggregateCheck checks only.
+	Results []CheckResult
+}
+
+// Offline reports whether the scan runs without network access
+func (ctx Context) Offline() bool {
+	return ctx.Client == nil
 }
 
 type Check interface {
@@ -39,6 +97,114 @@ type Check interface {
 	Run(ctx Context) (CheckResult, error)
 }
 
+// MultiCheck is implemented by checks that can report each finding as its
+// own result. The runner prefers RunMulti over Run when it is available.
+type MultiCheck interface {
+	Check
+	RunMulti(ctx Context) ([]CheckResult, error)
+}
+
+// NetworkCheck is implemented by checks that only test live URLs, DNS, or
+// remote registries. In offline mode the runner skips them with
+// OfflineResult instead of calling Run. Checks that also read project files
+// stay regular checks and fall back to the files when ctx.Client is nil.
+type NetworkCheck interface {
+	Check
+	RequiresNetwork() bool
+}
+
+// OfflineResult is the uniform result for a network check skipped offline
+func OfflineResult(check Check) CheckResult {
+	return CheckResult{
+		ID:       check.ID(),
+		Title:    check.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Skipped:  true,
+		Message:  "Offline mode, skipping",
+	}
+}
+
+// TimedOutResult is the result for a check that was still running, or not
+// yet started, when the scan deadline ran out
+func TimedOutResult(check Check) CheckResult {
+	return CheckResult{
+		ID:       check.ID(),
+		Title:    check.Title(),
+		Severity: SeverityWarn,
+		Passed:   false,
+		Message:  "Timed out: the scan deadline (--deadline) ran out",
+		Suggestions: []string{
+			"Raise --deadline, or ignore the check if the host it calls is unreachable from here",
+		},
+	}
+}
+
+// DependentCheck is implemented by checks that only make sense once other
+// checks have passed, e.g. validating sitemap URLs needs a reachable site.
+// The runner runs dependencies first and skips the check with
+// DependencySkippedResult when one failed with an error or was skipped.
+// Checks that don't implement it have no dependencies.
+type DependentCheck interface {
+	Check
+	DependsOn() []string
+}
+
+// DependencySkippedResult is the result for a check skipped because the
+// check it depends on (dependency) failed or was skipped, as reason says
+func DependencySkippedResult(check Check, dependency, reason string) CheckResult {
+	return CheckResult{
+		ID:       check.ID(),
+		Title:    check.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Skipped:  true,
+		Message:  "Depends on " + dependency + ", which " + reason,
+	}
+}
+
+// AggregateCheck is implemented by checks that judge other checks' results
+// instead of the project, e.g. "some error tracker is set up". The runner
+// runs them after all other checks, with ctx.Results filled in.
+type AggregateCheck interface {
+	Check
+	Aggregates() bool
+}
+
+// PageCheck is implemented by checks that read project files but can also
+// judge a site from its live pages alone. With scan --url they run; other
+// file-based checks are skipped with NoSourceResult.
+type PageCheck interface {
+	Check
+	RunsFromPage() bool
+}
+
+// RunsFromURL reports whether check can run against a live site without
+// any local source: network checks, and page checks that opt in
+func RunsFromURL(check Check) bool {
+	if network, ok := check.(NetworkCheck); ok && network.RequiresNetwork() {
+		return true
+	}
+	page, ok := check.(PageCheck)
+	return ok && page.RunsFromPage()
+}
+
+// NoSourceResult is the uniform result for a file-based check skipped
+// when scanning a URL with no local repo
+func NoSourceResult(check Check) CheckResult {
+	return CheckResult{
+		ID:       check.ID(),
+		Title:    check.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Skipped:  true,
+		Message:  "No local source (--url), skipping",
+	}
+}
+
+// errOffline is returned by doGet when there is no client
+var errOffline = errors.New("offline mode")
+
 // Registry of all available checks
 var Registry = []Check{
 	EnvParityCheck{},
@@ -74,6 +240,33 @@ var Registry = []Check{
 	WWWRedirectCheck{},
 	LegalPagesCheck{},
 	IndexNowCheck{},
+	HTTPVersionCheck{},
+	CORSCheck{},
+	OpenRedirectCheck{},
+	CSPCheck{},
+	SRICheck{},
+	DuplicateAnalyticsCheck{},
+	TrackingConsentCheck{},
+	TrailingSlashCheck{},
+	HreflangCheck{},
+	DockerfileCheck{},
+	DockerComposeCheck{},
+	HardcodedURLsCheck{},
+	TodoMarkersCheck{},
+	OutdatedDepsCheck{},
+	RobotsMetaCheck{},
+	BasicAuthCheck{},
+	DuplicateMetaCheck{},
+	LockfileCheck{},
+	CanonicalHostCheck{},
+	BuildOutputCheck{},
+	ProdConfigCheck{},
+	StripeKeysCheck{},
+	ErrorMonitoringCheck{},
+	AnalyticsConfiguredCheck{},
+	ReachabilityCheck{},
+	MinifiedAssetsCheck{},
+	DuplicatePageMetaCheck{},
 	// Cookie Consent checks
 	CookieConsentJSCheck{},
 	CookiebotCheck{},
@@ -143,6 +336,10 @@ var Registry = []Check{
 	HotjarCheck{},
 	AmplitudeCheck{},
 	SegmentCheck{},
+	// Ad Pixels
+	MetaPixelCheck{},
+	TikTokPixelCheck{},
+	LinkedInInsightCheck{},
 	// Error Tracking (extended)
 	BugsnagCheck{},
 	RollbarCheck{},
@@ -152,6 +349,15 @@ var Registry = []Check{
 	LogRocketCheck{},
 }
 
+// IDs returns the ID of every registered check, in registry order
+func IDs() []string {
+	ids := make([]string, 0, len(Registry))
+	for _, check := range Registry {
+		ids = append(ids, check.ID())
+	}
+	return ids
+}
+
 // isLocalURL checks if a URL points to localhost or local IP
 func isLocalURL(url string) bool {
 	url = strings.ToLower(url)
@@ -163,16 +369,43 @@ func isLocalURL(url string) bool {
 		strings.HasSuffix(url, ".ddev.site")
 }
 
-// doGet performs an HTTP GET with a User-Agent header
+// DefaultUserAgent is sent with every request unless overridden
+const DefaultUserAgent = "Preflight/1.0"
+
+// doGet performs an HTTP GET. The User-Agent comes from the client (see
+// WithUserAgent). A nil client (offline mode) fails with errOffline rather
+// than panicking.
 func doGet(client *http.Client, url string) (*http.Response, error) {
+	if client == nil {
+		return nil, errOffline
+	}
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("User-Agent", "Preflight/1.0")
+	if err := limiter.Wait(clientContext(client)); err != nil {
+		return nil, err
+	}
 	return client.Do(req)
 }
 
+// noRedirectClient returns a copy of client that returns the first response
+// instead of following redirects. ctx.Client follows redirects, which suits
+// checks that care about the final page (headers, SEO, health). Checks that
+// inspect the redirect itself use this instead: www_redirect,
+// trailing_slash, open_redirect, and legal_pages (whose probes would
+// otherwise count a redirect to the homepage as the page existing).
+func noRedirectClient(client *http.Client) *http.Client {
+	if client == nil {
+		return nil
+	}
+	copied := *client
+	copied.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return &copied
+}
+
 // tryURL attempts to reach a URL, trying both protocols for local URLs
 func tryURL(client *http.Client, url string) (*http.Response, string, error) {
 	// If it's a local URL without protocol, try both
@@ -254,3 +487,132 @@ func stripComments(content string) string {
 
 	return content
 }
+
+// pageSource is markup gathered from a layout file or the live homepage
+type pageSource struct {
+	Name    string // relative layout path or fetched URL
+	Content string
+}
+
+var htmlCommentPattern = regexp.MustCompile(`(?s)<!--.*?-->`)
+
+// collectPageSources returns the main layout file(s) and, when a URL is
+// configured, the live homepage. Only HTML comments are stripped, since
+// stripComments would treat the // in URLs as a line comment.
+func collectPageSources(ctx Context) []pageSource {
+	sources := collectLayoutSources(ctx)
+	if page, ok := fetchHomepage(ctx); ok {
+		sources = append(sources, page)
+	}
+	return sources
+}
+
+// fetchHomepage fetches the production (or staging) homepage with HTML
+// comments removed. ok is false when no URL is configured, the scan is
+// offline, or the request fails or returns an error page.
+func fetchHomepage(ctx Context) (pageSource, bool) {
+	baseURL := ctx.Config.URLs.Production
+	if baseURL == "" {
+		baseURL = ctx.Config.URLs.Staging
+	}
+	if baseURL == "" {
+		return pageSource{}, false
+	}
+	// The trailing slash makes relative asset paths resolve under the base path
+	if ctx.Config.URLs.BasePath != "" {
+		baseURL = withBasePath(ctx, baseURL) + "/"
+	}
+	resp, actualURL, err := tryURL(ctx.Client, baseURL)
+	if err != nil {
+		return pageSource{}, false
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+	resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return pageSource{}, false
+	}
+	return pageSource{
+		Name:    actualURL,
+		Content: htmlCommentPattern.ReplaceAllString(string(body), ""),
+	}, true
+}
+
+// renderedHTML returns the live homepage for the SEO checks, which see
+// runtime-injected metadata (helmet, generateMetadata, partials) that the
+// layout source doesn't show. ok is false when seoMeta.source is "layout"
+// or the page can't be fetched, and the checks fall back to the layout.
+func renderedHTML(ctx Context) (pageSource, bool) {
+	if cfg := ctx.Config.Checks.SEOMeta; cfg != nil && strings.EqualFold(cfg.Source, "layout") {
+		return pageSource{}, false
+	}
+	return fetchHomepage(ctx)
+}
+
+// collectLayoutSources returns the configured and stack-default layout files
+// that exist, with HTML comments removed
+func collectLayoutSources(ctx Context) []pageSource {
+	var sources []pageSource
+	seen := make(map[string]bool)
+
+	var configuredLayout string
+	if ctx.Config.Checks.SEOMeta != nil {
+		configuredLayout = ctx.Config.Checks.SEOMeta.MainLayout
+	}
+	layouts := scopedPaths(ctx.RootDir, getLayoutFilesForStack(ctx.Config.Stack))
+	if layoutFile := getLayoutFile(ctx.RootDir, ctx.Config.Stack, configuredLayout); layoutFile != "" {
+		layouts = append([]string{layoutFile}, layouts...)
+	}
+	for _, layout := range layouts {
+		if seen[layout] {
+			continue
+		}
+		seen[layout] = true
+		if content, err := os.ReadFile(filepath.Join(ctx.RootDir, layout)); err == nil {
+			sources = append(sources, pageSource{
+				Name:    layout,
+				Content: htmlCommentPattern.ReplaceAllString(string(content), ""),
+			})
+		}
+	}
+
+	return sources
+}
+
+// withBasePath returns the URL the app is served from: baseURL's origin
+// joined with urls.basePath, without a trailing slash. Requests for the app's
+// own pages (homepage, health, legal pages) go through it. Without a
+// basePath, baseURL is returned unchanged.
+func withBasePath(ctx Context, baseURL string) string {
+	basePath := strings.Trim(ctx.Config.URLs.BasePath, "/")
+	if basePath == "" {
+		return baseURL
+	}
+	return siteRoot(ctx, baseURL) + "/" + basePath
+}
+
+// siteRoot returns baseURL's origin when urls.basePath is set, for files
+// that must be served from the domain root whatever the app's path
+// (robots.txt, llms.txt, IndexNow keys). Without a basePath, baseURL is
+// returned unchanged.
+func siteRoot(ctx Context, baseURL string) string {
+	if strings.Trim(ctx.Config.URLs.BasePath, "/") == "" {
+		return baseURL
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" {
+		return baseURL
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// siteHostname returns the lowercase hostname of the production (or staging) URL
+func siteHostname(ctx Context) string {
+	baseURL := ctx.Config.URLs.Production
+	if baseURL == "" {
+		baseURL = ctx.Config.URLs.Staging
+	}
+	if u, err := url.Parse(baseURL); err == nil {
+		return strings.ToLower(u.Hostname())
+	}
+	return ""
+}
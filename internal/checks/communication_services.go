@@ -23,6 +23,7 @@ func (c TwilioCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Twilio not declared, skipping",
 		}, nil
 	}
@@ -89,6 +90,7 @@ func (c SlackCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Slack not declared, skipping",
 		}, nil
 	}
@@ -153,6 +155,7 @@ func (c DiscordCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Discord not declared, skipping",
 		}, nil
 	}
@@ -217,6 +220,7 @@ func (c IntercomCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Intercom not declared, skipping",
 		}, nil
 	}
@@ -282,6 +286,7 @@ func (c CrispCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Crisp not declared, skipping",
 		}, nil
 	}
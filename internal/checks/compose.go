@@ -0,0 +1,247 @@
+package checks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DockerComposeCheck flags docker-compose settings that are unsafe in production
+type DockerComposeCheck struct{}
+
+func (c DockerComposeCheck) ID() string {
+	return "docker_compose"
+}
+
+func (c DockerComposeCheck) Title() string {
+	return "Docker Compose"
+}
+
+// composeFileNames are the file names docker compose looks for, in order
+var composeFileNames = []string{"compose.yaml", "compose.yml", "docker-compose.yml", "docker-compose.yaml"}
+
+// composeDatabasePorts are container ports of services that shouldn't be public
+var composeDatabasePorts = map[string]string{
+	"5432":  "PostgreSQL",
+	"3306":  "MySQL",
+	"27017": "MongoDB",
+	"6379":  "Redis",
+	"11211": "Memcached",
+	"9200":  "Elasticsearch",
+	"5672":  "RabbitMQ",
+}
+
+var composeEnvReference = regexp.MustCompile(`^\$\{?[A-Za-z_]`)
+
+type composeFile struct {
+	Services map[string]composeService `yaml:"services"`
+}
+
+type composeService struct {
+	Image       string        `yaml:"image"`
+	Build       interface{}   `yaml:"build"`
+	Restart     string        `yaml:"restart"`
+	Ports       []interface{} `yaml:"ports"`
+	Volumes     []interface{} `yaml:"volumes"`
+	Environment interface{}   `yaml:"environment"`
+	Deploy      struct {
+		RestartPolicy interface{} `yaml:"restart_policy"`
+	} `yaml:"deploy"`
+}
+
+func (c DockerComposeCheck) Run(ctx Context) (CheckResult, error) {
+	var composePath string
+	for _, path := range scopedPaths(ctx.RootDir, composeFileNames) {
+		if _, err := os.Stat(filepath.Join(ctx.RootDir, path)); err == nil {
+			composePath = path
+			break
+		}
+	}
+	if composePath == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No compose file found, skipping",
+		}, nil
+	}
+
+	content, err := os.ReadFile(filepath.Join(ctx.RootDir, composePath))
+	if err != nil {
+		return CheckResult{}, err
+	}
+
+	var compose composeFile
+	if err := yaml.Unmarshal(content, &compose); err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("Could not parse %s: %v", composePath, err),
+		}, nil
+	}
+
+	// Services built from source with the code bind-mounted are a dev setup
+	if isDevCompose(compose) {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  composePath + " looks like a local development setup, skipping",
+		}, nil
+	}
+
+	names := make([]string, 0, len(compose.Services))
+	for name := range compose.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var details []string
+	for _, name := range names {
+		for _, problem := range composeServiceProblems(compose.Services[name]) {
+			details = append(details, name+": "+problem)
+		}
+	}
+
+	if len(details) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  fmt.Sprintf("No issues found in %s (%d services)", composePath, len(names)),
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityWarn,
+		Passed:   false,
+		Message:  fmt.Sprintf("%d issue(s) in %s", len(details), composePath),
+		Suggestions: []string{
+			"Set restart: unless-stopped (or always) on long-running services",
+			"Bind database ports to 127.0.0.1 or leave them unpublished",
+			"Pin images to a version tag and load secrets from env_file or ${VAR} references",
+		},
+		Details: details,
+	}, nil
+}
+
+// isDevCompose reports whether any service builds from source with a bind mount
+func isDevCompose(compose composeFile) bool {
+	for _, service := range compose.Services {
+		if service.Build == nil {
+			continue
+		}
+		for _, volume := range service.Volumes {
+			// Long syntax: {type: bind, source: ., target: /app}
+			if long, ok := volume.(map[string]interface{}); ok {
+				if long["type"] == "bind" {
+					return true
+				}
+				continue
+			}
+			source := strings.SplitN(fmt.Sprint(volume), ":", 2)[0]
+			if strings.HasPrefix(source, ".") || strings.HasPrefix(source, "/") || strings.HasPrefix(source, "~") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// composeServiceProblems lists production anti-patterns in one service
+func composeServiceProblems(service composeService) []string {
+	var problems []string
+
+	if service.Restart == "" && service.Deploy.RestartPolicy == nil {
+		problems = append(problems, "no restart policy")
+	}
+
+	if service.Image != "" && service.Build == nil {
+		if problem := baseImageProblem(service.Image, nil); problem != "" {
+			problems = append(problems, strings.Replace(problem, "base image", "image", 1))
+		}
+	}
+
+	for _, port := range service.Ports {
+		host, container := splitComposePort(port)
+		if container == "" {
+			continue
+		}
+		if db, ok := composeDatabasePorts[container]; ok && host != "127.0.0.1" && host != "localhost" {
+			problems = append(problems, fmt.Sprintf("%s port %s is published on all interfaces", db, container))
+		}
+	}
+
+	for _, name := range composePlaintextSecrets(service.Environment) {
+		problems = append(problems, fmt.Sprintf("environment %s is set in plaintext", name))
+	}
+
+	return problems
+}
+
+// splitComposePort returns the host IP (if any) and container port of a port
+// mapping. Short syntax looks like "127.0.0.1:5432:5432"; long syntax is a
+// map with host_ip, target, and published.
+func splitComposePort(mapping interface{}) (string, string) {
+	if long, ok := mapping.(map[string]interface{}); ok {
+		if long["published"] == nil {
+			return "", ""
+		}
+		host, _ := long["host_ip"].(string)
+		return host, fmt.Sprint(long["target"])
+	}
+	port := strings.SplitN(fmt.Sprint(mapping), "/", 2)[0]
+	parts := strings.Split(port, ":")
+	container := parts[len(parts)-1]
+	if len(parts) == 3 {
+		return parts[0], container
+	}
+	return "", container
+}
+
+// composePlaintextSecrets returns secret-looking environment variables with
+// literal values. environment may be a map or a list of KEY=VALUE strings.
+func composePlaintextSecrets(env interface{}) []string {
+	values := make(map[string]string)
+	switch e := env.(type) {
+	case map[string]interface{}:
+		for key, value := range e {
+			if value != nil {
+				values[key] = fmt.Sprint(value)
+			}
+		}
+	case []interface{}:
+		for _, item := range e {
+			parts := strings.SplitN(fmt.Sprint(item), "=", 2)
+			if len(parts) == 2 {
+				values[parts[0]] = parts[1]
+			}
+		}
+	}
+
+	var names []string
+	for key, value := range values {
+		if value == "" || composeEnvReference.MatchString(value) {
+			continue
+		}
+		if dockerSecretNamePattern.MatchString(key) {
+			names = append(names, key)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -25,6 +25,7 @@ func (c CookieConsentJSCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Cookie Consent not declared, skipping",
 		}, nil
 	}
@@ -113,6 +114,7 @@ func (c CookiebotCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Cookiebot not declared, skipping",
 		}, nil
 	}
@@ -222,6 +224,7 @@ func (c OneTrustCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "OneTrust not declared, skipping",
 		}, nil
 	}
@@ -330,6 +333,7 @@ func (c TermlyCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Termly not declared, skipping",
 		}, nil
 	}
@@ -435,6 +439,7 @@ func (c CookieYesCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "CookieYes not declared, skipping",
 		}, nil
 	}
@@ -541,6 +546,7 @@ func (c IubendaCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Iubenda not declared, skipping",
 		}, nil
 	}
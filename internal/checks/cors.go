@@ -0,0 +1,151 @@
+package checks
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// corsProbeOrigin is an origin no real site should trust
+const corsProbeOrigin = "https://preflight-cors-probe.example"
+
+// CORSCheck flags permissive Access-Control-Allow-Origin responses,
+// especially wildcard or reflected origins combined with credentials
+type CORSCheck struct{}
+
+func (c CORSCheck) ID() string {
+	return "cors"
+}
+
+func (c CORSCheck) Title() string {
+	return "CORS configuration"
+}
+
+func (c CORSCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c CORSCheck) DependsOn() []string {
+	return siteDependencies
+}
+
+func (c CORSCheck) Run(ctx Context) (CheckResult, error) {
+	baseURL := ctx.Config.URLs.Production
+	if baseURL == "" {
+		baseURL = ctx.Config.URLs.Staging
+	}
+	if baseURL == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No staging or production URL configured, skipping",
+		}, nil
+	}
+
+	var details []string
+	var problems []string
+	severity := SeverityInfo
+	reachable := false
+
+	// A simple cross-origin GET and an OPTIONS preflight can be configured differently
+	for _, method := range []string{"GET", "OPTIONS"} {
+		resp, err := corsRequest(ctx.Client, method, baseURL)
+		if err != nil {
+			details = append(details, fmt.Sprintf("%s: %v", method, err))
+			continue
+		}
+		resp.Body.Close()
+		reachable = true
+
+		allowOrigin := resp.Header.Get("Access-Control-Allow-Origin")
+		allowCreds := strings.EqualFold(resp.Header.Get("Access-Control-Allow-Credentials"), "true")
+
+		if allowOrigin == "" {
+			details = append(details, fmt.Sprintf("%s: no Access-Control-Allow-Origin header", method))
+			continue
+		}
+		details = append(details, fmt.Sprintf("%s: Access-Control-Allow-Origin: %s", method, allowOrigin))
+		if creds := resp.Header.Get("Access-Control-Allow-Credentials"); creds != "" {
+			details = append(details, fmt.Sprintf("%s: Access-Control-Allow-Credentials: %s", method, creds))
+		}
+		if methods := resp.Header.Get("Access-Control-Allow-Methods"); methods != "" {
+			details = append(details, fmt.Sprintf("%s: Access-Control-Allow-Methods: %s", method, methods))
+		}
+
+		var problem string
+		switch {
+		case allowOrigin == "*" && allowCreds:
+			problem = "wildcard origin with credentials"
+			severity = SeverityError
+		case (allowOrigin == corsProbeOrigin || allowOrigin == "null") && allowCreds:
+			problem = "arbitrary origin reflected with credentials"
+			severity = SeverityError
+		case allowOrigin == corsProbeOrigin:
+			problem = "arbitrary origin reflected"
+			if severity != SeverityError {
+				severity = SeverityWarn
+			}
+		}
+		if problem != "" && !contains(problems, problem) {
+			problems = append(problems, problem)
+		}
+	}
+
+	if !reachable {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "Could not reach site to check CORS headers",
+			Details:  details,
+		}, nil
+	}
+
+	if len(problems) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: severity,
+			Passed:   false,
+			Message:  "Permissive CORS: " + strings.Join(problems, ", "),
+			Suggestions: []string{
+				"Only allow specific, trusted origins in Access-Control-Allow-Origin",
+				"Never combine Access-Control-Allow-Credentials: true with a wildcard or reflected origin",
+			},
+			Details: details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "No permissive CORS headers for untrusted origins",
+		Details:  details,
+	}, nil
+}
+
+// corsRequest sends a cross-origin request from corsProbeOrigin
+func corsRequest(client *http.Client, method, url string) (*http.Response, error) {
+	if client == nil {
+		return nil, errOffline
+	}
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Origin", corsProbeOrigin)
+	if method == "OPTIONS" {
+		req.Header.Set("Access-Control-Request-Method", "GET")
+		req.Header.Set("Access-Control-Request-Headers", "authorization")
+	}
+	if err := limiter.Wait(clientContext(client)); err != nil {
+		return nil, err
+	}
+	return client.Do(req)
+}
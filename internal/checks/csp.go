@@ -0,0 +1,171 @@
+package checks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CSPCheck analyzes the Content-Security-Policy header for weak directives.
+// SecurityHeadersCheck reports a missing CSP; this check grades an existing one.
+type CSPCheck struct{}
+
+func (c CSPCheck) ID() string {
+	return "csp"
+}
+
+func (c CSPCheck) Title() string {
+	return "Content-Security-Policy quality"
+}
+
+func (c CSPCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c CSPCheck) DependsOn() []string {
+	return siteDependencies
+}
+
+func (c CSPCheck) Run(ctx Context) (CheckResult, error) {
+	baseURL := ctx.Config.URLs.Production
+	if baseURL == "" {
+		baseURL = ctx.Config.URLs.Staging
+	}
+	if baseURL == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No staging or production URL configured, skipping",
+		}, nil
+	}
+
+	resp, _, err := tryURL(ctx.Client, baseURL)
+	if err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "Could not reach site to analyze CSP",
+		}, nil
+	}
+	resp.Body.Close()
+
+	policy := resp.Header.Get("Content-Security-Policy")
+	if policy == "" {
+		// Absence is already reported by securityHeaders
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No Content-Security-Policy header, skipping analysis",
+		}, nil
+	}
+
+	weaknesses := analyzeCSP(parseCSP(policy))
+	if len(weaknesses) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("CSP has %d weak directive(s)", len(weaknesses)),
+			Suggestions: []string{
+				"Replace 'unsafe-inline' with nonces or hashes for inline scripts",
+				"Set default-src 'self' and list specific hosts instead of wildcards",
+				"Add frame-ancestors 'self' (or 'none') to prevent clickjacking",
+			},
+			Details: weaknesses,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "No weak CSP directives found",
+	}, nil
+}
+
+// parseCSP splits a policy into lowercase directive names and their sources
+func parseCSP(policy string) map[string][]string {
+	directives := make(map[string][]string)
+	for _, part := range strings.Split(policy, ";") {
+		fields := strings.Fields(strings.TrimSpace(part))
+		if len(fields) == 0 {
+			continue
+		}
+		name := strings.ToLower(fields[0])
+		// Per spec, only the first occurrence of a directive is used
+		if _, exists := directives[name]; !exists {
+			directives[name] = fields[1:]
+		}
+	}
+	return directives
+}
+
+// analyzeCSP returns a description of each weakness in the parsed policy
+func analyzeCSP(directives map[string][]string) []string {
+	var weaknesses []string
+
+	defaultSrc, hasDefault := directives["default-src"]
+	if !hasDefault {
+		weaknesses = append(weaknesses, "default-src is missing (unlisted resource types are unrestricted)")
+	}
+
+	// script-src falls back to default-src when absent
+	scriptDirective := "script-src"
+	scriptSrc, hasScript := directives["script-src"]
+	if !hasScript {
+		scriptDirective = "default-src"
+		scriptSrc = defaultSrc
+	}
+
+	// 'unsafe-inline' is ignored by browsers when a nonce or hash is present
+	hasNonceOrHash := false
+	for _, src := range scriptSrc {
+		lower := strings.ToLower(src)
+		if strings.HasPrefix(lower, "'nonce-") || strings.HasPrefix(lower, "'sha") {
+			hasNonceOrHash = true
+		}
+	}
+	for _, src := range scriptSrc {
+		switch strings.ToLower(src) {
+		case "'unsafe-inline'":
+			if !hasNonceOrHash {
+				weaknesses = append(weaknesses, scriptDirective+" allows 'unsafe-inline'")
+			}
+		case "'unsafe-eval'":
+			weaknesses = append(weaknesses, scriptDirective+" allows 'unsafe-eval'")
+		case "http:", "https:", "data:":
+			weaknesses = append(weaknesses, fmt.Sprintf("%s allows any %s source", scriptDirective, src))
+		}
+	}
+
+	// Wildcard sources in any directive
+	names := make([]string, 0, len(directives))
+	for name := range directives {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, src := range directives[name] {
+			if src == "*" {
+				weaknesses = append(weaknesses, name+" allows wildcard source *")
+				break
+			}
+		}
+	}
+
+	if _, ok := directives["frame-ancestors"]; !ok {
+		weaknesses = append(weaknesses, "frame-ancestors is missing (page can be framed for clickjacking)")
+	}
+
+	return weaknesses
+}
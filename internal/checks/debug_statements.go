@@ -2,7 +2,6 @@ package checks
 
 import (
 	"fmt"
-	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -19,7 +18,7 @@ func (c DebugStatementsCheck) Title() string {
 }
 
 func (c DebugStatementsCheck) Run(ctx Context) (CheckResult, error) {
-	findings := scanForDebugStatements(ctx.RootDir)
+	findings := scanForDebugStatements(ctx.RootDir, ctx.Config.Stack)
 
 	if len(findings) == 0 {
 		return CheckResult{
@@ -41,7 +40,7 @@ func (c DebugStatementsCheck) Run(ctx Context) (CheckResult, error) {
 			suggestions = append(suggestions, fmt.Sprintf("... and %d more", len(findings)-maxFindings))
 			break
 		}
-		suggestions = append(suggestions, finding)
+		suggestions = append(suggestions, finding.String())
 	}
 
 	return CheckResult{
@@ -51,17 +50,47 @@ func (c DebugStatementsCheck) Run(ctx Context) (CheckResult, error) {
 		Passed:      false,
 		Message:     message,
 		Suggestions: suggestions,
+		Locations:   findings,
 	}, nil
 }
 
 type debugPattern struct {
 	pattern     *regexp.Regexp
 	description string
-	extensions  []string // file extensions to check (empty = all supported)
+	extensions  []string       // file extensions to check (empty = all supported)
+	stacks      []string       // only check for these stacks (empty = all stacks)
+	dirs        []string       // only check files under these directories (empty = anywhere)
+	fileMatch   *regexp.Regexp // only check files whose content matches (nil = all)
 }
 
-func scanForDebugStatements(rootDir string) []string {
-	var findings []string
+// appliesTo reports whether the pattern should run against a file
+func (p debugPattern) appliesTo(stack, relPath, ext, content string) bool {
+	if len(p.extensions) > 0 && !contains(p.extensions, ext) {
+		return false
+	}
+	if len(p.stacks) > 0 && !contains(p.stacks, stack) {
+		return false
+	}
+	if len(p.dirs) > 0 {
+		inDir := false
+		for _, dir := range p.dirs {
+			if strings.HasPrefix(filepath.ToSlash(relPath), dir+"/") {
+				inDir = true
+				break
+			}
+		}
+		if !inDir {
+			return false
+		}
+	}
+	if p.fileMatch != nil && !p.fileMatch.MatchString(content) {
+		return false
+	}
+	return true
+}
+
+func scanForDebugStatements(rootDir, stack string) []Location {
+	var findings []Location
 
 	// Debug patterns by language
 	patterns := []debugPattern{
@@ -103,6 +132,14 @@ func scanForDebugStatements(rootDir string) []string {
 			description: "pp (pretty print)",
 			extensions:  []string{".rb", ".erb", ".rake"},
 		},
+		{
+			// puts is legitimate in rake tasks and scripts, so only flag app code
+			pattern:     regexp.MustCompile(`^\s*puts\s+\S`),
+			description: "puts",
+			extensions:  []string{".rb"},
+			stacks:      []string{"rails"},
+			dirs:        []string{"app"},
+		},
 
 		// PHP
 		{
@@ -157,6 +194,14 @@ func scanForDebugStatements(rootDir string) []string {
 			description: "import ipdb",
 			extensions:  []string{".py"},
 		},
+		{
+			// print() is normal in CLI scripts, so only flag web app code
+			pattern:     regexp.MustCompile(`^\s*print\s*\(`),
+			description: "print()",
+			extensions:  []string{".py"},
+			stacks:      []string{"django", "python"},
+			fileMatch:   regexp.MustCompile(`\b(HttpResponse|render\(|request\.|@app\.route|@router\.|APIView)`),
+		},
 
 		// Go
 		{
@@ -169,6 +214,14 @@ func scanForDebugStatements(rootDir string) []string {
 			description: "spew.Dump()",
 			extensions:  []string{".go"},
 		},
+		{
+			// Printing to stdout from an HTTP handler is almost always leftover debugging
+			pattern:     regexp.MustCompile(`\bfmt\.Print(ln|f)?\s*\(`),
+			description: "fmt.Println in handler",
+			extensions:  []string{".go"},
+			stacks:      []string{"go"},
+			fileMatch:   regexp.MustCompile(`http\.ResponseWriter|\*gin\.Context|echo\.Context|\*fiber\.Ctx`),
+		},
 
 		// Rust
 		{
@@ -219,32 +272,9 @@ func scanForDebugStatements(rootDir string) []string {
 		},
 	}
 
-	// Directories to skip
-	skipDirs := map[string]bool{
-		"node_modules":   true,
-		"vendor":         true,
-		".git":           true,
-		"dist":           true,
-		"build":          true,
-		".next":          true,
-		".nuxt":          true,
-		"coverage":       true,
-		"__pycache__":    true,
-		".cache":         true,
-		"tmp":            true,
-		"log":            true,
-		"logs":           true,
-		"storage":        true,
-		"cpresources":    true,
-		".turbo":         true,
-		".vercel":        true,
-		".netlify":       true,
-		"public":         true,
-		"static":         true,
-		"_site":          true,
-		"out":            true,
-		"assets":         true,
-	}
+	// Directories to skip in addition to the shared skip rules: logs,
+	// uploads, and compiled web assets
+	skipDirs := []string{"log", "logs", "storage", "cpresources", "public", "static", "_site", "out", "assets"}
 
 	skipFiles := []string{
 		".min.js",
@@ -287,27 +317,26 @@ func scanForDebugStatements(rootDir string) []string {
 		"stimulus",
 	}
 
-	// Walk the project
-	filepath.WalkDir(rootDir, func(path string, d os.DirEntry, err error) error {
-		if err != nil {
-			return nil
-		}
-
-		// Skip directories
-		if d.IsDir() {
-			if skipDirs[d.Name()] {
-				return filepath.SkipDir
-			}
-			return nil
+	// Scan the project's indexed files
+	index := fileIndexFor(rootDir)
+	for _, file := range index.Files("") {
+		if hasPathComponent(file.RelPath, skipDirs...) {
+			continue
 		}
 
 		// Check if file should be skipped
-		filename := strings.ToLower(d.Name())
+		path := file.Path
+		filename := strings.ToLower(filepath.Base(path))
+		skipped := false
 		for _, skip := range skipFiles {
 			if strings.Contains(filename, skip) {
-				return nil
+				skipped = true
+				break
 			}
 		}
+		if skipped {
+			continue
+		}
 
 		// Get file extension
 		ext := strings.ToLower(filepath.Ext(path))
@@ -318,19 +347,21 @@ func scanForDebugStatements(rootDir string) []string {
 		}
 
 		// Skip files larger than 500KB
-		info, err := d.Info()
-		if err != nil || info.Size() > 500*1024 {
-			return nil
+		if file.Size > 500*1024 {
+			continue
 		}
 
 		// Read file content
-		content, err := os.ReadFile(path)
+		content, err := index.ReadFile(path)
 		if err != nil {
-			return nil
+			continue
 		}
 
+		relPath := filepath.FromSlash(file.RelPath)
+		contentStr := string(content)
+
 		// Check each line for patterns
-		lines := strings.Split(string(content), "\n")
+		lines := strings.Split(contentStr, "\n")
 		for lineNum, line := range lines {
 			// Skip commented lines (basic check)
 			trimmedLine := strings.TrimSpace(line)
@@ -344,31 +375,18 @@ func scanForDebugStatements(rootDir string) []string {
 			}
 
 			for _, p := range patterns {
-				// Check if this pattern applies to this file type
-				if len(p.extensions) > 0 {
-					matches := false
-					for _, e := range p.extensions {
-						if ext == e {
-							matches = true
-							break
-						}
-					}
-					if !matches {
-						continue
-					}
+				if !p.appliesTo(stack, relPath, ext, contentStr) {
+					continue
 				}
 
 				if p.pattern.MatchString(line) {
 					if !isDevGuarded(lines, lineNum) && !isInCodeExample(lines, lineNum) {
-						relPath, _ := filepath.Rel(rootDir, path)
-						findings = append(findings, fmt.Sprintf("%s:%d - %s", relPath, lineNum+1, p.description))
+						findings = append(findings, Location{File: relPath, Line: lineNum + 1, Message: p.description})
 					}
 				}
 			}
 		}
-
-		return nil
-	})
+	}
 
 	return findings
 }
@@ -0,0 +1,217 @@
+package checks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// DockerfileCheck flags common Dockerfile mistakes that matter in production
+type DockerfileCheck struct{}
+
+func (c DockerfileCheck) ID() string {
+	return "dockerfile"
+}
+
+func (c DockerfileCheck) Title() string {
+	return "Dockerfile"
+}
+
+var dockerSecretNamePattern = regexp.MustCompile(`(?i)(password|passwd|secret|token|api_?key|private_?key|access_?key|credentials)`)
+
+// dockerInstruction is one logical Dockerfile instruction, with continuation
+// lines joined
+type dockerInstruction struct {
+	Line    int
+	Command string // upper-cased instruction, e.g. FROM
+	Args    string
+}
+
+func (c DockerfileCheck) Run(ctx Context) (CheckResult, error) {
+	var dockerfile string
+	for _, path := range scopedPaths(ctx.RootDir, []string{"Dockerfile"}) {
+		if _, err := os.Stat(filepath.Join(ctx.RootDir, path)); err == nil {
+			dockerfile = path
+			break
+		}
+	}
+	if dockerfile == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No Dockerfile found, skipping",
+		}, nil
+	}
+
+	content, err := os.ReadFile(filepath.Join(ctx.RootDir, dockerfile))
+	if err != nil {
+		return CheckResult{}, err
+	}
+
+	instructions := parseDockerfile(string(content))
+	findings := lintDockerfile(dockerfile, instructions)
+	if len(findings) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  "No issues found in " + dockerfile,
+		}, nil
+	}
+
+	// Show the offending instruction alongside each finding
+	text := make(map[int]string)
+	for _, in := range instructions {
+		text[in.Line] = truncate(in.Command+" "+in.Args, 60)
+	}
+	var details []string
+	for _, finding := range findings {
+		details = append(details, fmt.Sprintf("Line %d: %s (%s)", finding.Line, finding.Message, text[finding.Line]))
+	}
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityWarn,
+		Passed:   false,
+		Message:  fmt.Sprintf("%d issue(s) in %s", len(findings), dockerfile),
+		Suggestions: []string{
+			"Add a non-root USER to the final stage",
+			"Pin base images to a version tag or digest",
+			"Pass secrets with BuildKit --mount=type=secret or at runtime, not ARG/ENV",
+		},
+		Details: details,
+	}, nil
+}
+
+// parseDockerfile splits a Dockerfile into instructions, skipping comments
+// and joining lines ending in a backslash
+func parseDockerfile(content string) []dockerInstruction {
+	var instructions []dockerInstruction
+	var current strings.Builder
+	start := 0
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if current.Len() == 0 && (trimmed == "" || strings.HasPrefix(trimmed, "#")) {
+			continue
+		}
+		if current.Len() == 0 {
+			start = i + 1
+		}
+		if strings.HasSuffix(trimmed, "\\") {
+			current.WriteString(strings.TrimSuffix(trimmed, "\\") + " ")
+			continue
+		}
+		current.WriteString(trimmed)
+
+		fields := strings.SplitN(current.String(), " ", 2)
+		instruction := dockerInstruction{Line: start, Command: strings.ToUpper(fields[0])}
+		if len(fields) > 1 {
+			instruction.Args = strings.TrimSpace(fields[1])
+		}
+		instructions = append(instructions, instruction)
+		current.Reset()
+	}
+	return instructions
+}
+
+// lintDockerfile returns a finding per problem, pointing at the offending line
+func lintDockerfile(path string, instructions []dockerInstruction) []Location {
+	var findings []Location
+	stages := make(map[string]bool)
+	finalFrom := 0
+	var user string
+	hasHealthcheck := false
+
+	for _, in := range instructions {
+		switch in.Command {
+		case "FROM":
+			finalFrom = in.Line
+			user = ""
+			image, stage := parseFromArgs(in.Args)
+			if stage != "" {
+				stages[strings.ToLower(stage)] = true
+			}
+			if problem := baseImageProblem(image, stages); problem != "" {
+				findings = append(findings, Location{File: path, Line: in.Line, Message: problem})
+			}
+		case "USER":
+			user = in.Args
+		case "HEALTHCHECK":
+			hasHealthcheck = true
+		case "ARG", "ENV":
+			name := strings.SplitN(strings.SplitN(in.Args, "=", 2)[0], " ", 2)[0]
+			if dockerSecretNamePattern.MatchString(name) {
+				findings = append(findings, Location{
+					File:    path,
+					Line:    in.Line,
+					Message: fmt.Sprintf("%s %s may bake a secret into the image", in.Command, name),
+				})
+			}
+		case "ADD":
+			for _, arg := range strings.Fields(in.Args) {
+				if strings.HasPrefix(arg, "http://") || strings.HasPrefix(arg, "https://") {
+					findings = append(findings, Location{
+						File:    path,
+						Line:    in.Line,
+						Message: "ADD of a remote URL; use RUN curl with a checksum instead",
+					})
+					break
+				}
+			}
+		}
+	}
+
+	if finalFrom == 0 {
+		return findings
+	}
+	if user == "" || user == "root" || strings.HasPrefix(user, "0") {
+		findings = append(findings, Location{File: path, Line: finalFrom, Message: "final stage runs as root (no non-root USER)"})
+	}
+	if !hasHealthcheck {
+		findings = append(findings, Location{File: path, Line: finalFrom, Message: "no HEALTHCHECK instruction"})
+	}
+	return findings
+}
+
+// parseFromArgs returns the image and optional stage name from FROM arguments
+func parseFromArgs(args string) (string, string) {
+	var fields []string
+	for _, field := range strings.Fields(args) {
+		if !strings.HasPrefix(field, "--") {
+			fields = append(fields, field)
+		}
+	}
+	if len(fields) == 0 {
+		return "", ""
+	}
+	if len(fields) >= 3 && strings.EqualFold(fields[1], "as") {
+		return fields[0], fields[2]
+	}
+	return fields[0], ""
+}
+
+// baseImageProblem reports an unpinned base image tag
+func baseImageProblem(image string, stages map[string]bool) string {
+	if image == "" || image == "scratch" || stages[strings.ToLower(image)] || strings.Contains(image, "$") {
+		return ""
+	}
+	if strings.Contains(image, "@sha256:") {
+		return ""
+	}
+	// A tag follows the last colon after the last slash (a registry may have a port)
+	name := image[strings.LastIndex(image, "/")+1:]
+	idx := strings.LastIndex(name, ":")
+	if idx < 0 {
+		return fmt.Sprintf("base image %s has no tag (implicitly latest)", image)
+	}
+	if name[idx+1:] == "latest" {
+		return fmt.Sprintf("base image %s uses the latest tag", image)
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+package checks
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DuplicateMetaCheck flags singleton head tags that appear more than once,
+// which usually means two layouts or partials both inject the same tag
+type DuplicateMetaCheck struct{}
+
+func (c DuplicateMetaCheck) ID() string {
+	return "duplicate_meta"
+}
+
+func (c DuplicateMetaCheck) Title() string {
+	return "Duplicate head tags"
+}
+
+func (c DuplicateMetaCheck) RunsFromPage() bool {
+	return true
+}
+
+// singletonHeadTag is a tag that should appear at most once per page
+type singletonHeadTag struct {
+	Name    string
+	Pattern *regexp.Regexp
+}
+
+var singletonHeadTags = []singletonHeadTag{
+	{"<title>", regexp.MustCompile(`(?i)<title[\s>]`)},
+	{"viewport meta", regexp.MustCompile(`(?i)<meta\s[^>]*name\s*=\s*["']?viewport["'\s>/]`)},
+	{"canonical link", regexp.MustCompile(`(?i)<link\s[^>]*rel\s*=\s*["']?canonical["'\s>/]`)},
+	{"charset declaration", regexp.MustCompile(`(?i)<meta\s[^>]*(charset\s*=|http-equiv\s*=\s*["']?content-type)`)},
+}
+
+var (
+	headBlockPattern = regexp.MustCompile(`(?is)<head[\s>].*?</head>`)
+	// svgBlockPattern removes inline SVGs, whose <title> elements are
+	// accessible names rather than page titles
+	svgBlockPattern = regexp.MustCompile(`(?is)<svg[\s>].*?</svg>`)
+)
+
+func (c DuplicateMetaCheck) Run(ctx Context) (CheckResult, error) {
+	// The rendered page is the most accurate source, since templates may
+	// emit a tag from several branches of which only one renders
+	sources := collectPageSources(ctx)
+	if len(sources) > 0 && isRemoteSource(sources[len(sources)-1]) {
+		sources = sources[len(sources)-1:]
+	}
+
+	if len(sources) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No layout file or URL found, skipping",
+		}, nil
+	}
+
+	var problems, details []string
+	for _, source := range sources {
+		content := source.Content
+		if head := headBlockPattern.FindString(content); head != "" {
+			content = head
+		}
+		content = svgBlockPattern.ReplaceAllString(content, "")
+
+		for _, tag := range singletonHeadTags {
+			count := len(tag.Pattern.FindAllStringIndex(content, -1))
+			if count > 1 {
+				problems = append(problems, fmt.Sprintf("%d %s tags in %s", count, tag.Name, source.Name))
+				details = append(details, fmt.Sprintf("%s: %d %s tags", source.Name, count, tag.Name))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "Duplicate head tags: " + strings.Join(problems, ", "),
+			Suggestions: []string{
+				"Keep one of each tag and remove the others",
+				"Check for a layout and a partial (or SEO plugin) that both output the same tag",
+			},
+			Details: details,
+		}, nil
+	}
+
+	names := make([]string, len(sources))
+	for i, source := range sources {
+		names[i] = source.Name
+	}
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "No duplicate title, viewport, canonical, or charset tags",
+		Details:  []string{"Checked: " + strings.Join(names, ", ")},
+	}, nil
+}
+
+// isRemoteSource reports whether source was fetched from a URL rather than
+// read from a layout file
+func isRemoteSource(source pageSource) bool {
+	return strings.HasPrefix(source.Name, "http://") || strings.HasPrefix(source.Name, "https://")
+}
@@ -0,0 +1,199 @@
+package checks
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DuplicatePageMetaCheck crawls the homepage and a few of the pages it links
+// to, and flags pages that share a <title> or meta description. Search
+// engines report duplicate metadata and may show the wrong page in results.
+type DuplicatePageMetaCheck struct{}
+
+func (c DuplicatePageMetaCheck) ID() string {
+	return "duplicate_page_meta"
+}
+
+func (c DuplicatePageMetaCheck) Title() string {
+	return "Unique titles & descriptions"
+}
+
+func (c DuplicatePageMetaCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c DuplicatePageMetaCheck) DependsOn() []string {
+	return siteDependencies
+}
+
+// maxCrawlPages bounds the crawl, homepage included
+const maxCrawlPages = 10
+
+var titleTextPattern = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+
+// pageMeta is the title and description of one crawled page
+type pageMeta struct {
+	Path        string
+	Title       string
+	Description string
+}
+
+func (c DuplicatePageMetaCheck) Run(ctx Context) (CheckResult, error) {
+	home, ok := fetchHomepage(ctx)
+	if !ok {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No site URL configured or homepage unavailable, skipping",
+		}, nil
+	}
+	base, err := url.Parse(home.Name)
+	if err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "Invalid site URL, skipping",
+		}, nil
+	}
+
+	pages := []pageMeta{extractPageMeta(displayPath(base), home.Content)}
+	for _, link := range internalPageLinks(base, home.Content, maxCrawlPages-1) {
+		resp, err := doGet(ctx.Client, link.String())
+		if err != nil {
+			continue
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+		resp.Body.Close()
+		if resp.StatusCode != 200 || !strings.Contains(resp.Header.Get("Content-Type"), "html") {
+			continue
+		}
+		content := htmlCommentPattern.ReplaceAllString(string(body), "")
+		pages = append(pages, extractPageMeta(displayPath(link), content))
+	}
+
+	if len(pages) < 2 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No internal pages found to compare, skipping",
+		}, nil
+	}
+
+	titles := duplicateValues(pages, func(p pageMeta) string { return p.Title })
+	descriptions := duplicateValues(pages, func(p pageMeta) string { return p.Description })
+
+	details := []string{fmt.Sprintf("Crawled %d page(s)", len(pages))}
+	for _, d := range titles {
+		details = append(details, fmt.Sprintf("Title %q on %s", d.value, strings.Join(d.paths, ", ")))
+	}
+	for _, d := range descriptions {
+		details = append(details, fmt.Sprintf("Description %q on %s", truncate(d.value, 80), strings.Join(d.paths, ", ")))
+	}
+
+	if len(titles) == 0 && len(descriptions) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  fmt.Sprintf("%d crawled pages have unique titles and descriptions", len(pages)),
+			Details:  details,
+		}, nil
+	}
+
+	var problems []string
+	if len(titles) > 0 {
+		problems = append(problems, fmt.Sprintf("%d duplicate title(s)", len(titles)))
+	}
+	if len(descriptions) > 0 {
+		problems = append(problems, fmt.Sprintf("%d duplicate description(s)", len(descriptions)))
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityWarn,
+		Passed:   false,
+		Message:  strings.Join(problems, ", ") + " across crawled pages",
+		Suggestions: []string{
+			"Give each page its own <title> and meta description describing that page",
+			"Set them per route (e.g. generateMetadata in Next.js, a title block in your templates) instead of only in the layout",
+		},
+		Details: details,
+	}, nil
+}
+
+// extractPageMeta reads the title and meta description from a page's head
+func extractPageMeta(path, content string) pageMeta {
+	head := content
+	if block := headBlockPattern.FindString(content); block != "" {
+		head = block
+	}
+	head = svgBlockPattern.ReplaceAllString(head, "")
+
+	meta := pageMeta{Path: path}
+	if m := titleTextPattern.FindStringSubmatch(head); m != nil {
+		meta.Title = normalizeMetaText(m[1])
+	}
+	meta.Description = normalizeMetaText(extractMetaContent(head, `name=["']description["']`))
+	return meta
+}
+
+// normalizeMetaText decodes entities and collapses whitespace so equivalent
+// values compare equal
+func normalizeMetaText(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(s)), " ")
+}
+
+// displayPath is a page's path for Details, / for the root
+func displayPath(u *url.URL) string {
+	if u.Path == "" {
+		return "/"
+	}
+	return u.Path
+}
+
+type duplicateValue struct {
+	value string
+	paths []string
+}
+
+// duplicateValues groups pages by a non-empty field and returns the values
+// shared by more than one page, in order of first appearance
+func duplicateValues(pages []pageMeta, field func(pageMeta) string) []duplicateValue {
+	byValue := make(map[string][]string)
+	var order []string
+	for _, p := range pages {
+		v := field(p)
+		if v == "" {
+			continue
+		}
+		if _, seen := byValue[v]; !seen {
+			order = append(order, v)
+		}
+		byValue[v] = append(byValue[v], p.Path)
+	}
+
+	var dups []duplicateValue
+	for _, v := range order {
+		if paths := byValue[v]; len(paths) > 1 {
+			sort.Strings(paths)
+			dups = append(dups, duplicateValue{v, paths})
+		}
+	}
+	return dups
+}
@@ -1,6 +1,7 @@
 package checks
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/url"
@@ -17,6 +18,10 @@ func (c EmailAuthCheck) Title() string {
 	return "Email authentication (SPF/DMARC)"
 }
 
+func (c EmailAuthCheck) RequiresNetwork() bool {
+	return true
+}
+
 func (c EmailAuthCheck) Run(ctx Context) (CheckResult, error) {
 	if ctx.Config.URLs.Production == "" {
 		return CheckResult{
@@ -24,6 +29,7 @@ func (c EmailAuthCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Skipped (no production URL)",
 		}, nil
 	}
@@ -35,12 +41,13 @@ func (c EmailAuthCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Skipped (could not parse domain)",
 		}, nil
 	}
 
-	hasSPF, spfRecord := checkSPF(domain)
-	hasDMARC, dmarcRecord := checkDMARC(domain)
+	hasSPF, spfRecord := checkSPF(ctx, domain)
+	hasDMARC, dmarcRecord := checkDMARC(ctx, domain)
 
 	var missing []string
 	if !hasSPF {
@@ -93,8 +100,8 @@ func extractDomain(rawURL string) (string, error) {
 	return parsed.Hostname(), nil
 }
 
-func checkSPF(domain string) (bool, string) {
-	records, err := net.LookupTXT(domain)
+func checkSPF(ctx context.Context, domain string) (bool, string) {
+	records, err := net.DefaultResolver.LookupTXT(ctx, domain)
 	if err != nil {
 		return false, ""
 	}
@@ -107,8 +114,8 @@ func checkSPF(domain string) (bool, string) {
 	return false, ""
 }
 
-func checkDMARC(domain string) (bool, string) {
-	records, err := net.LookupTXT("_dmarc." + domain)
+func checkDMARC(ctx context.Context, domain string) (bool, string) {
+	records, err := net.DefaultResolver.LookupTXT(ctx, "_dmarc."+domain)
 	if err != nil {
 		return false, ""
 	}
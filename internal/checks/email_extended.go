@@ -23,6 +23,7 @@ func (c MailchimpCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Mailchimp not declared, skipping",
 		}, nil
 	}
@@ -89,6 +90,7 @@ func (c ConvertKitCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Kit not declared, skipping",
 		}, nil
 	}
@@ -153,6 +155,7 @@ func (c BeehiivCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Beehiiv not declared, skipping",
 		}, nil
 	}
@@ -216,6 +219,7 @@ func (c AWeberCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "AWeber not declared, skipping",
 		}, nil
 	}
@@ -278,6 +282,7 @@ func (c ActiveCampaignCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "ActiveCampaign not declared, skipping",
 		}, nil
 	}
@@ -340,6 +345,7 @@ func (c CampaignMonitorCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Campaign Monitor not declared, skipping",
 		}, nil
 	}
@@ -402,6 +408,7 @@ func (c DripCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Drip not declared, skipping",
 		}, nil
 	}
@@ -465,6 +472,7 @@ func (c KlaviyoCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Klaviyo not declared, skipping",
 		}, nil
 	}
@@ -528,6 +536,7 @@ func (c ButtondownCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Buttondown not declared, skipping",
 		}, nil
 	}
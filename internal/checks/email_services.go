@@ -27,6 +27,7 @@ func (c PostmarkCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Postmark not declared, skipping",
 		}, nil
 	}
@@ -93,6 +94,7 @@ func (c SendGridCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "SendGrid not declared, skipping",
 		}, nil
 	}
@@ -157,6 +159,7 @@ func (c MailgunCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Mailgun not declared, skipping",
 		}, nil
 	}
@@ -221,6 +224,7 @@ func (c ResendCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Resend not declared, skipping",
 		}, nil
 	}
@@ -285,6 +289,7 @@ func (c AWSSESCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "AWS SES not declared, skipping",
 		}, nil
 	}
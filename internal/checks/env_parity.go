@@ -26,6 +26,7 @@ func (c EnvParityCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Check not configured",
 		}, nil
 	}
@@ -42,6 +43,7 @@ func (c EnvParityCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "No " + cfg.ExampleFile + " found (skipped)",
 		}, nil
 	}
@@ -1,9 +1,83 @@
 package checks
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 )
 
+// errorTrackingServices are the service checks for error trackers
+var errorTrackingServices = []string{"sentry", "bugsnag", "rollbar", "honeybadger", "datadog", "newrelic", "logrocket"}
+
+// ErrorMonitoringCheck warns when no error tracker is set up, since errors in
+// production would then go unnoticed until users report them. It reads the
+// results of the service checks above instead of scanning again.
+type ErrorMonitoringCheck struct{}
+
+func (c ErrorMonitoringCheck) ID() string {
+	return "error_monitoring"
+}
+
+func (c ErrorMonitoringCheck) Title() string {
+	return "Error monitoring"
+}
+
+func (c ErrorMonitoringCheck) Aggregates() bool {
+	return true
+}
+
+func (c ErrorMonitoringCheck) Run(ctx Context) (CheckResult, error) {
+	var working, broken, details []string
+	for _, r := range ctx.Results {
+		if !contains(errorTrackingServices, r.ID) || r.Skipped {
+			continue
+		}
+		if r.Passed {
+			working = append(working, r.Title)
+		} else {
+			broken = append(broken, r.Title)
+		}
+		details = append(details, fmt.Sprintf("%s: %s", r.Title, r.Message))
+	}
+
+	if len(working) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  "Errors are reported to " + strings.Join(working, ", "),
+			Details:  details,
+		}, nil
+	}
+
+	if len(broken) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  strings.Join(broken, ", ") + " declared but not set up, so production errors go unreported",
+			Suggestions: []string{
+				"Finish setting up the error tracker (see its check above)",
+			},
+			Details: details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityWarn,
+		Passed:   false,
+		Message:  "No error tracking found, so production errors go unnoticed",
+		Suggestions: []string{
+			"Add an error tracker such as Sentry, Bugsnag, Rollbar, or Honeybadger",
+			"Declare it under services in preflight.yml (or rerun 'preflight init') so preflight verifies it",
+		},
+	}, nil
+}
+
 // BugsnagCheck verifies Bugsnag is properly set up
 type BugsnagCheck struct{}
 
@@ -23,6 +97,7 @@ func (c BugsnagCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Bugsnag not declared, skipping",
 		}, nil
 	}
@@ -79,6 +154,7 @@ func (c RollbarCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Rollbar not declared, skipping",
 		}, nil
 	}
@@ -134,6 +210,7 @@ func (c HoneybadgerCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Honeybadger not declared, skipping",
 		}, nil
 	}
@@ -189,6 +266,7 @@ func (c DatadogCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Datadog not declared, skipping",
 		}, nil
 	}
@@ -245,6 +323,7 @@ func (c NewRelicCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "New Relic not declared, skipping",
 		}, nil
 	}
@@ -300,6 +379,7 @@ func (c LogRocketCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "LogRocket not declared, skipping",
 		}, nil
 	}
@@ -1,6 +1,13 @@
 package checks
 
 import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -21,6 +28,9 @@ func (c FaviconCheck) Run(ctx Context) (CheckResult, error) {
 	var found []string
 	var missing []string
 
+	// When the site is reachable, check the favicon it actually serves
+	liveDetails, liveProblem, liveOK, rootMissing := checkLiveFavicon(ctx)
+
 	// Common web root directories across frameworks
 	webRoots := []string{
 		"public",     // Laravel, Rails, many Node.js
@@ -86,25 +96,29 @@ func (c FaviconCheck) Run(ctx Context) (CheckResult, error) {
 	// Flexible search: walk app directories for dynamic icon files (Next.js icon.tsx, etc.)
 	if !hasFavicon {
 		flexIconDirs := []string{"app", "src/app"}
-		for _, dir := range flexIconDirs {
+		for _, dirPath := range scopedDirs(ctx.RootDir, flexIconDirs) {
 			if hasFavicon {
 				break
 			}
-			dirPath := filepath.Join(ctx.RootDir, dir)
 			if _, err := os.Stat(dirPath); err != nil {
 				continue
 			}
 			filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+				if ctx.Err() != nil {
+					return ctx.Err() // the scan deadline ran out
+				}
 				if err != nil || hasFavicon {
 					return nil
 				}
 				if info.IsDir() {
-					name := info.Name()
-					if name == "node_modules" || name == ".git" {
+					if shouldSkip(ctx.RootDir, path, true) {
 						return filepath.SkipDir
 					}
 					return nil
 				}
+				if shouldSkip(ctx.RootDir, path, false) {
+					return nil
+				}
 				nameLower := strings.ToLower(info.Name())
 				// Match icon.tsx, icon.ts, icon.jsx, icon.js, favicon.tsx, etc.
 				if nameLower == "icon.tsx" || nameLower == "icon.ts" || nameLower == "icon.jsx" || nameLower == "icon.js" ||
@@ -119,6 +133,11 @@ func (c FaviconCheck) Run(ctx Context) (CheckResult, error) {
 		}
 	}
 
+	// A valid favicon served by the site counts even if it isn't in the repo
+	if !hasFavicon && liveOK {
+		hasFavicon = true
+	}
+
 	if !hasFavicon {
 		missing = append(missing, "favicon")
 	}
@@ -230,25 +249,29 @@ func (c FaviconCheck) Run(ctx Context) (CheckResult, error) {
 	// Flexible search: walk app directories for dynamic apple-icon files
 	if !hasAppleIcon {
 		flexAppleDirs := []string{"app", "src/app"}
-		for _, dir := range flexAppleDirs {
+		for _, dirPath := range scopedDirs(ctx.RootDir, flexAppleDirs) {
 			if hasAppleIcon {
 				break
 			}
-			dirPath := filepath.Join(ctx.RootDir, dir)
 			if _, err := os.Stat(dirPath); err != nil {
 				continue
 			}
 			filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+				if ctx.Err() != nil {
+					return ctx.Err() // the scan deadline ran out
+				}
 				if err != nil || hasAppleIcon {
 					return nil
 				}
 				if info.IsDir() {
-					name := info.Name()
-					if name == "node_modules" || name == ".git" {
+					if shouldSkip(ctx.RootDir, path, true) {
 						return filepath.SkipDir
 					}
 					return nil
 				}
+				if shouldSkip(ctx.RootDir, path, false) {
+					return nil
+				}
 				nameLower := strings.ToLower(info.Name())
 				// Match apple-icon.tsx, apple-icon.ts, etc.
 				if strings.HasPrefix(nameLower, "apple-icon.") && (strings.HasSuffix(nameLower, ".tsx") || strings.HasSuffix(nameLower, ".ts") || strings.HasSuffix(nameLower, ".jsx") || strings.HasSuffix(nameLower, ".js")) {
@@ -317,25 +340,29 @@ func (c FaviconCheck) Run(ctx Context) (CheckResult, error) {
 	// Flexible search: walk app directories for dynamic manifest files
 	if !hasManifest {
 		flexManifestDirs := []string{"app", "src/app"}
-		for _, dir := range flexManifestDirs {
+		for _, dirPath := range scopedDirs(ctx.RootDir, flexManifestDirs) {
 			if hasManifest {
 				break
 			}
-			dirPath := filepath.Join(ctx.RootDir, dir)
 			if _, err := os.Stat(dirPath); err != nil {
 				continue
 			}
 			filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+				if ctx.Err() != nil {
+					return ctx.Err() // the scan deadline ran out
+				}
 				if err != nil || hasManifest {
 					return nil
 				}
 				if info.IsDir() {
-					name := info.Name()
-					if name == "node_modules" || name == ".git" {
+					if shouldSkip(ctx.RootDir, path, true) {
 						return filepath.SkipDir
 					}
 					return nil
 				}
+				if shouldSkip(ctx.RootDir, path, false) {
+					return nil
+				}
 				nameLower := strings.ToLower(info.Name())
 				// Match manifest.ts, manifest.tsx, manifest.js, manifest.jsx, webmanifest files
 				if nameLower == "manifest.ts" || nameLower == "manifest.tsx" || nameLower == "manifest.js" || nameLower == "manifest.jsx" {
@@ -354,28 +381,57 @@ func (c FaviconCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// Determine result
-	if len(missing) == 0 {
+	if len(missing) == 0 && liveProblem == "" && rootMissing {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   false,
+			Message:  "No /favicon.ico at the site root",
+			Suggestions: []string{
+				"Browsers request /favicon.ico even when a <link> points elsewhere, so each visit logs a 404",
+				"Serve a favicon.ico from the web root (or redirect /favicon.ico to your icon)",
+			},
+			Details: liveDetails,
+		}, nil
+	}
+
+	if len(missing) == 0 && liveProblem == "" {
 		return CheckResult{
 			ID:       c.ID(),
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
 			Message:  "All icons and manifest present",
+			Details:  liveDetails,
 		}, nil
 	}
 
 	if hasFavicon && len(missing) <= 2 {
-		// Has favicon but missing apple icon or manifest - just warn
+		// Has favicon but missing apple icon or manifest, or the served
+		// favicon is broken - just warn
+		message := "Missing: " + joinStrings(missing, ", ")
+		suggestions := []string{
+			"Add apple-touch-icon.png (180x180px) for iOS",
+			"Add manifest.json for PWA support",
+		}
+		if liveProblem != "" {
+			if len(missing) > 0 {
+				suggestions = append([]string{message}, suggestions...)
+			} else {
+				suggestions = nil
+			}
+			message = liveProblem
+			suggestions = append([]string{"Make sure the favicon <link> points at a file that is deployed"}, suggestions...)
+		}
 		return CheckResult{
-			ID:       c.ID(),
-			Title:    c.Title(),
-			Severity: SeverityWarn,
-			Passed:   false,
-			Message:  "Missing: " + joinStrings(missing, ", "),
-			Suggestions: []string{
-				"Add apple-touch-icon.png (180x180px) for iOS",
-				"Add manifest.json for PWA support",
-			},
+			ID:          c.ID(),
+			Title:       c.Title(),
+			Severity:    SeverityWarn,
+			Passed:      false,
+			Message:     message,
+			Suggestions: suggestions,
+			Details:     liveDetails,
 		}, nil
 	}
 
@@ -389,9 +445,168 @@ func (c FaviconCheck) Run(ctx Context) (CheckResult, error) {
 			"Add favicon.ico or favicon.png to public/",
 			"Use https://realfavicongenerator.net for complete icon set",
 		},
+		Details: liveDetails,
 	}, nil
 }
 
+var faviconRelPattern = regexp.MustCompile(`(?i)\brel\s*=\s*["'](?:shortcut\s+)?icon["']`)
+
+// checkLiveFavicon fetches the favicon the live homepage links to (or
+// /favicon.ico) and verifies it decodes to an image of a sensible size.
+// ok is true when a valid favicon was served. When the page links a favicon
+// elsewhere, /favicon.ico is probed too, since browsers request it anyway;
+// rootMissing is true when it 404s.
+func checkLiveFavicon(ctx Context) (details []string, problem string, ok, rootMissing bool) {
+	var page *pageSource
+	sources := collectPageSources(ctx)
+	for i := range sources {
+		if strings.HasPrefix(sources[i].Name, "http") {
+			page = &sources[i]
+		}
+	}
+	if page == nil {
+		return nil, "", false, false
+	}
+
+	href := "/favicon.ico"
+	linked := false
+	for _, tag := range linkTagPattern.FindAllString(page.Content, -1) {
+		if !faviconRelPattern.MatchString(tag) {
+			continue
+		}
+		if m := hrefPattern.FindStringSubmatch(tag); m != nil && strings.TrimSpace(m[1]) != "" {
+			href = strings.TrimSpace(m[1])
+			linked = true
+			break
+		}
+	}
+
+	base, err := url.Parse(page.Name)
+	if err != nil {
+		return nil, "", false, false
+	}
+	rootURL := base.ResolveReference(&url.URL{Path: "/favicon.ico"}).String()
+
+	// Inline data: URIs can't 404
+	if strings.HasPrefix(href, "data:") {
+		details = []string{"Favicon: inline data URI"}
+		rootDetail, missing := checkRootFavicon(ctx, rootURL)
+		if rootDetail != "" {
+			details = append(details, rootDetail)
+		}
+		return details, "", true, missing
+	}
+
+	ref, err := url.Parse(href)
+	if err != nil {
+		return nil, "Favicon link has an invalid URL: " + href, false, false
+	}
+	faviconURL := base.ResolveReference(ref).String()
+	details = append(details, "Favicon URL: "+faviconURL)
+	if linked && faviconURL != rootURL {
+		rootDetail, missing := checkRootFavicon(ctx, rootURL)
+		if rootDetail != "" {
+			details = append(details, rootDetail)
+		}
+		rootMissing = missing
+	}
+
+	// SVG icons have no raster dimensions; just make sure it's SVG
+	if strings.HasSuffix(strings.ToLower(ref.Path), ".svg") {
+		resp, err := doGet(ctx.Client, faviconURL)
+		if err != nil {
+			return details, "", false, rootMissing
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		resp.Body.Close()
+		if resp.StatusCode != 200 {
+			return details, fmt.Sprintf("Favicon %s returned HTTP %d", faviconURL, resp.StatusCode), false, rootMissing
+		}
+		if !strings.Contains(strings.ToLower(string(body)), "<svg") {
+			return details, "Favicon " + faviconURL + " is not a valid SVG image", false, rootMissing
+		}
+		return append(details, "Favicon format: SVG"), "", true, rootMissing
+	}
+
+	width, height, err := fetchImageDimensions(ctx, faviconURL)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "HTTP ") {
+			return details, fmt.Sprintf("Favicon %s returned %s", faviconURL, err), false, rootMissing
+		}
+		if errors.Is(err, image.ErrFormat) {
+			return details, "Favicon " + faviconURL + " is not a valid image (an HTML fallback page?)", false, rootMissing
+		}
+		// Network errors: nothing to report
+		return details, "", false, rootMissing
+	}
+	details = append(details, fmt.Sprintf("Favicon size: %dx%d", width, height))
+
+	if width < 16 || height < 16 {
+		return details, fmt.Sprintf("Favicon is only %dx%d; use at least 32x32", width, height), false, rootMissing
+	}
+	if width > 1024 || height > 1024 {
+		return details, fmt.Sprintf("Favicon is %dx%d; it's downloaded on every first visit, so keep it at 512x512 or smaller", width, height), false, rootMissing
+	}
+	return details, "", true, rootMissing
+}
+
+// checkRootFavicon requests /favicon.ico and describes the response for
+// Details. missing is true only for a 404; other failures aren't reported.
+func checkRootFavicon(ctx Context, rootURL string) (detail string, missing bool) {
+	resp, err := doGet(ctx.Client, rootURL)
+	if err != nil {
+		return "", false
+	}
+	resp.Body.Close()
+	if resp.StatusCode == 404 {
+		return "Root /favicon.ico: 404 (not found)", true
+	}
+	return fmt.Sprintf("Root /favicon.ico: %d", resp.StatusCode), false
+}
+
+func init() {
+	// Let image.DecodeConfig read favicon.ico headers
+	image.RegisterFormat("ico", "\x00\x00\x01\x00", decodeICO, decodeICOConfig)
+}
+
+// decodeICOConfig reports the largest image in an ICO file's directory
+func decodeICOConfig(r io.Reader) (image.Config, error) {
+	var header struct {
+		Reserved, Type, Count uint16
+	}
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return image.Config{}, err
+	}
+	if header.Count == 0 {
+		return image.Config{}, image.ErrFormat
+	}
+
+	config := image.Config{ColorModel: color.RGBAModel}
+	for i := 0; i < int(header.Count); i++ {
+		var entry [16]byte
+		if _, err := io.ReadFull(r, entry[:]); err != nil {
+			return image.Config{}, err
+		}
+		// A size byte of 0 means 256
+		width, height := int(entry[0]), int(entry[1])
+		if width == 0 {
+			width = 256
+		}
+		if height == 0 {
+			height = 256
+		}
+		if width > config.Width {
+			config.Width, config.Height = width, height
+		}
+	}
+	return config, nil
+}
+
+// decodeICO is unsupported; only the directory is read
+func decodeICO(r io.Reader) (image.Image, error) {
+	return nil, errors.New("ico: decoding pixels is not supported")
+}
+
 func joinStrings(strs []string, sep string) string {
 	if len(strs) == 0 {
 		return ""
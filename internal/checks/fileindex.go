@@ -0,0 +1,237 @@
+package checks
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// maxCachedFileSize keeps the content cache from holding large assets
+const maxCachedFileSize = 1024 * 1024 // 1 MB
+
+// IndexedFile is one file found by the project walk
+type IndexedFile struct {
+	Path    string // absolute path
+	RelPath string // slash-separated path relative to the project root
+	Size    int64
+}
+
+// FileIndex lists a project's files once so checks can query them instead of
+// walking the tree themselves. The walk honors shouldSkip, runs on first use,
+// and file contents are read lazily and cached.
+type FileIndex struct {
+	rootDir string
+	paths   []string // subdirectories file checks are scoped to, if any
+
+	once  sync.Once
+	files []IndexedFile
+
+	mu       sync.Mutex
+	contents map[string][]byte
+}
+
+var (
+	fileIndexMu sync.Mutex
+	fileIndexes = make(map[string]*FileIndex)
+)
+
+// NewFileIndex creates the index for rootDir and makes it the one returned to
+// helpers that only know the root directory. When paths are given, only those
+// subdirectories (relative to rootDir) are indexed and searched for layouts.
+func NewFileIndex(rootDir string, paths ...string) *FileIndex {
+	index := &FileIndex{
+		rootDir:  rootDir,
+		contents: make(map[string][]byte),
+	}
+	for _, p := range paths {
+		p = filepath.ToSlash(filepath.Clean(p))
+		if p != "." {
+			index.paths = append(index.paths, p)
+		}
+	}
+	fileIndexMu.Lock()
+	fileIndexes[filepath.Clean(rootDir)] = index
+	fileIndexMu.Unlock()
+	return index
+}
+
+// fileIndexFor returns the shared index for rootDir, creating it if needed
+func fileIndexFor(rootDir string) *FileIndex {
+	fileIndexMu.Lock()
+	index, ok := fileIndexes[filepath.Clean(rootDir)]
+	fileIndexMu.Unlock()
+	if ok {
+		return index
+	}
+	return NewFileIndex(rootDir)
+}
+
+// files returns the context's index, falling back to the shared one for its root
+func (ctx Context) files() *FileIndex {
+	if ctx.Files != nil {
+		return ctx.Files
+	}
+	return fileIndexFor(ctx.RootDir)
+}
+
+// scopes returns the scoped subdirectories, or "" for the whole project
+func (x *FileIndex) scopes() []string {
+	if len(x.paths) == 0 {
+		return []string{""}
+	}
+	return x.paths
+}
+
+// scopedPaths resolves fixed relative paths like "app" or a stack's layout
+// files inside each scoped subdirectory of rootDir. The results are still
+// relative to rootDir.
+func scopedPaths(rootDir string, relPaths []string) []string {
+	scopes := fileIndexFor(rootDir).scopes()
+	if len(scopes) == 1 && scopes[0] == "" {
+		return relPaths
+	}
+	var resolved []string
+	for _, scope := range scopes {
+		for _, relPath := range relPaths {
+			resolved = append(resolved, filepath.ToSlash(filepath.Join(scope, relPath)))
+		}
+	}
+	return resolved
+}
+
+// scopedDirs is scopedPaths returning absolute paths, for walkers that search
+// fixed directories
+func scopedDirs(rootDir string, dirs []string) []string {
+	var resolved []string
+	for _, dir := range scopedPaths(rootDir, dirs) {
+		resolved = append(resolved, filepath.Join(rootDir, dir))
+	}
+	return resolved
+}
+
+// readScoped reads the first scoped copy of a fixed relative path, e.g.
+// apps/web/package.json when file checks are scoped to apps/web
+func readScoped(rootDir, relPath string) ([]byte, error) {
+	err := error(os.ErrNotExist)
+	for _, path := range scopedDirs(rootDir, []string{relPath}) {
+		var content []byte
+		if content, err = os.ReadFile(path); err == nil {
+			return content, nil
+		}
+	}
+	return nil, err
+}
+
+// statScoped is readScoped for os.Stat
+func statScoped(rootDir, relPath string) (os.FileInfo, error) {
+	err := error(os.ErrNotExist)
+	for _, path := range scopedDirs(rootDir, []string{relPath}) {
+		var info os.FileInfo
+		if info, err = os.Stat(path); err == nil {
+			return info, nil
+		}
+	}
+	return nil, err
+}
+
+// scoped reports whether file checks are limited to paths subdirectories.
+// Monorepo-wide searches such as apps/*/public are skipped then, so one
+// app's scan doesn't pick up another app's files.
+func scoped(rootDir string) bool {
+	return len(fileIndexFor(rootDir).paths) > 0
+}
+
+func (x *FileIndex) build() {
+	for _, scope := range x.scopes() {
+		x.walk(filepath.Join(x.rootDir, scope))
+	}
+}
+
+func (x *FileIndex) walk(dir string) {
+	filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if shouldSkip(x.rootDir, path, true) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if shouldSkip(x.rootDir, path, false) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		relPath, _ := filepath.Rel(x.rootDir, path)
+		x.files = append(x.files, IndexedFile{
+			Path:    path,
+			RelPath: filepath.ToSlash(relPath),
+			Size:    info.Size(),
+		})
+		return nil
+	})
+}
+
+// Files returns indexed files under dir (relative to the root, "" or "." for
+// all) in walk order. When exts are given, only files with one of those
+// extensions are returned.
+func (x *FileIndex) Files(dir string, exts ...string) []IndexedFile {
+	x.once.Do(x.build)
+
+	prefix := filepath.ToSlash(filepath.Clean(dir))
+	if prefix == "." {
+		prefix = ""
+	} else {
+		prefix += "/"
+	}
+
+	var files []IndexedFile
+	for _, f := range x.files {
+		if prefix != "" && !strings.HasPrefix(f.RelPath, prefix) {
+			continue
+		}
+		if len(exts) > 0 && !contains(exts, filepath.Ext(f.RelPath)) {
+			continue
+		}
+		files = append(files, f)
+	}
+	return files
+}
+
+// ReadFile returns the contents of path, reading files up to 1 MB only once
+func (x *FileIndex) ReadFile(path string) ([]byte, error) {
+	x.mu.Lock()
+	content, ok := x.contents[path]
+	x.mu.Unlock()
+	if ok {
+		return content, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) <= maxCachedFileSize {
+		x.mu.Lock()
+		x.contents[path] = content
+		x.mu.Unlock()
+	}
+	return content, nil
+}
+
+// hasPathComponent reports whether any directory in the slash-separated
+// relative path has one of the given names
+func hasPathComponent(relPath string, names ...string) bool {
+	parts := strings.Split(relPath, "/")
+	for _, part := range parts[:len(parts)-1] {
+		if contains(names, part) {
+			return true
+		}
+	}
+	return false
+}
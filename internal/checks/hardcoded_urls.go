@@ -0,0 +1,176 @@
+package checks
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// HardcodedURLsCheck finds localhost and staging URLs left in source code,
+// which usually means an API base URL that will break in production
+type HardcodedURLsCheck struct{}
+
+func (c HardcodedURLsCheck) ID() string {
+	return "hardcoded_urls"
+}
+
+func (c HardcodedURLsCheck) Title() string {
+	return "Hardcoded dev URLs"
+}
+
+var (
+	localURLPattern   = regexp.MustCompile(`https?://(localhost|127\.0\.0\.1|0\.0\.0\.0)(:\d+)?[^\s"'\x60)<>]*`)
+	stagingURLPattern = regexp.MustCompile(`https?://([a-z0-9-]+\.)*(staging|stg)[.-][a-z0-9.-]+[^\s"'\x60)<>]*|https?://[a-z0-9-]+\.(ngrok\.io|ngrok-free\.app|ngrok\.app|loca\.lt|trycloudflare\.com)[^\s"'\x60)<>]*`)
+
+	// Comment patterns that keep line numbers and leave the // in URLs alone
+	blockCommentPattern = regexp.MustCompile(`(?s)/\*.*?\*/|<!--.*?-->|\{#.*?#\}`)
+	lineCommentPattern  = regexp.MustCompile(`(?m)(^|[\s;,(){}])//.*$`)
+	hashCommentPattern  = regexp.MustCompile(`(?m)^\s*#[^{!].*$`)
+)
+
+// hardcodedURLExtensions are the source files scanned
+var hardcodedURLExtensions = []string{
+	".js", ".jsx", ".ts", ".tsx", ".mjs", ".cjs", ".vue", ".svelte", ".astro",
+	".rb", ".erb", ".php", ".twig", ".py", ".go", ".rs", ".java", ".kt", ".ex", ".exs",
+	".html", ".njk", ".liquid",
+}
+
+func (c HardcodedURLsCheck) Run(ctx Context) (CheckResult, error) {
+	var allow []string
+	if ctx.Config.Checks.HardcodedURLs != nil {
+		allow = ctx.Config.Checks.HardcodedURLs.Allow
+	}
+
+	patterns := []*regexp.Regexp{localURLPattern, stagingURLPattern}
+	if host := configuredStagingHost(ctx.Config.URLs.Staging); host != "" {
+		patterns = append(patterns, regexp.MustCompile(`https?://`+regexp.QuoteMeta(host)+`[^\s"'\x60)<>]*`))
+	}
+
+	var findings []Location
+	index := ctx.files()
+	for _, file := range index.Files("", hardcodedURLExtensions...) {
+		if isTestFile(file.RelPath) || isConfigFile(file.RelPath) || file.Size > 500*1024 {
+			continue
+		}
+		content, err := index.ReadFile(file.Path)
+		if err != nil {
+			continue
+		}
+
+		lines := strings.Split(stripCommentsKeepLines(string(content)), "\n")
+		for i, line := range lines {
+			for _, pattern := range patterns {
+				match := pattern.FindString(line)
+				if match == "" || matchesAllowlist(allow, match, file.RelPath) || isDevOnly(lines, i) {
+					continue
+				}
+				findings = append(findings, Location{File: file.RelPath, Line: i + 1, Message: match})
+				break
+			}
+		}
+	}
+
+	if len(findings) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  "No hardcoded localhost or staging URLs found",
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityWarn,
+		Passed:   false,
+		Message:  fmt.Sprintf("Found %d hardcoded localhost/staging URL(s)", len(findings)),
+		Suggestions: []string{
+			"Read API base URLs from environment variables or build-time config",
+			"Allow intentional references with checks.hardcodedUrls.allow in preflight.yml",
+		},
+		Locations: findings,
+	}, nil
+}
+
+// stripCommentsKeepLines blanks out comments while keeping line numbers.
+// Unlike stripComments it only treats // as a comment after whitespace or
+// punctuation, so the // in URLs survives.
+func stripCommentsKeepLines(content string) string {
+	content = blockCommentPattern.ReplaceAllStringFunc(content, func(comment string) string {
+		return strings.Repeat("\n", strings.Count(comment, "\n"))
+	})
+	content = lineCommentPattern.ReplaceAllString(content, "$1")
+	return hashCommentPattern.ReplaceAllString(content, "")
+}
+
+// devOnlyGuards mark code that only runs in development. isDevGuarded isn't
+// used here because it treats any mention of localhost as a guard.
+var devOnlyGuards = []string{
+	"node_env", "import.meta.env.dev", "__dev__", "isdev", "isdevelopment",
+	"rails.env.development", "settings.debug", "app()->islocal", "@env('local')",
+	"debug_assertions", "gin.debugmode",
+}
+
+// isDevOnly reports whether the line or the three before it check for a
+// development environment
+func isDevOnly(lines []string, lineNum int) bool {
+	start := lineNum - 3
+	if start < 0 {
+		start = 0
+	}
+	for i := start; i <= lineNum; i++ {
+		lineLower := strings.ToLower(lines[i])
+		for _, guard := range devOnlyGuards {
+			if strings.Contains(lineLower, guard) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// configuredStagingHost returns the staging URL's host, unless it is local
+func configuredStagingHost(staging string) string {
+	if staging == "" {
+		return ""
+	}
+	if !strings.Contains(staging, "://") {
+		staging = "https://" + staging
+	}
+	parsed, err := url.Parse(staging)
+	if err != nil || isLocalURL(parsed.Host) {
+		return ""
+	}
+	return parsed.Hostname()
+}
+
+// isTestFile reports whether a relative path is a test, spec, or fixture
+func isTestFile(relPath string) bool {
+	name := strings.ToLower(filepath.Base(relPath))
+	if strings.Contains(name, ".test.") || strings.Contains(name, ".spec.") ||
+		strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_spec.rb") ||
+		strings.HasSuffix(name, "_test.rb") || strings.HasPrefix(name, "test_") {
+		return true
+	}
+	return hasPathComponent(relPath, "test", "tests", "__tests__", "spec", "e2e", "cypress", "fixtures", "__mocks__")
+}
+
+// isConfigFile reports whether a file is tooling config, where localhost is expected
+func isConfigFile(relPath string) bool {
+	name := strings.ToLower(filepath.Base(relPath))
+	return strings.Contains(name, ".config.") || strings.HasPrefix(name, "playwright") || name == "setupproxy.js"
+}
+
+// matchesAllowlist reports whether a hit is allowed by any entry
+func matchesAllowlist(allow []string, match, relPath string) bool {
+	for _, entry := range allow {
+		if entry != "" && (strings.Contains(match, entry) || strings.Contains(relPath, entry)) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,10 +4,16 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
+
+	"github.com/preflightsh/preflight/internal/config"
 )
 
 type HealthCheck struct{}
 
+// commonHealthPaths are probed in order when no path is configured
+var commonHealthPaths = []string{"/health", "/healthz", "/api/health", "/_health", "/status"}
+
 func (c HealthCheck) ID() string {
 	return "healthEndpoint"
 }
@@ -16,6 +22,14 @@ func (c HealthCheck) Title() string {
 	return "Health endpoint"
 }
 
+func (c HealthCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c HealthCheck) DependsOn() []string {
+	return siteDependencies
+}
+
 func (c HealthCheck) Run(ctx Context) (CheckResult, error) {
 	cfg := ctx.Config.Checks.HealthEndpoint
 
@@ -33,41 +47,133 @@ func (c HealthCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "No URLs configured to check",
 		}, nil
 	}
+	baseURL = withBasePath(ctx, baseURL)
 
 	baseURLs := []string{baseURL}
 
+	// Several configured paths (e.g. liveness and readiness) must all pass
+	if cfg != nil && len(cfg.Paths) > 0 {
+		return c.checkPaths(ctx, baseURL, cfg)
+	}
+
 	// If a specific path is configured, use it
 	if cfg != nil && cfg.Path != "" {
 		return c.checkPath(ctx, baseURLs, cfg.Path, true, false)
 	}
 
-	// Try common health endpoint paths first
-	commonPaths := []string{"/health", "/healthz", "/api/health", "/_health", "/status"}
-	for _, path := range commonPaths {
+	// Try common health endpoint paths first, remembering each probe so
+	// verbose output shows why a path was chosen
+	var probes []string
+	for _, path := range commonHealthPaths {
 		result, _ := c.checkPath(ctx, baseURLs, path, false, false)
 		if result.Passed {
+			result.Details = append(probes, result.Details...)
 			return result, nil
 		}
+		probes = append(probes, result.Details...)
 	}
 
 	// Fallback: check if the root URL is reachable (accept 2xx and 3xx)
-	return c.checkPath(ctx, baseURLs, "/", false, true)
+	result, err := c.checkPath(ctx, baseURLs, "/", false, true)
+	result.Details = append(probes, result.Details...)
+	return result, err
+}
+
+// checkPaths checks every configured path, plus healthEndpoint.path, and
+// reports each one's status. Unless skipCommonPaths is set, the common paths
+// are probed too and listed in Details when they respond, without affecting
+// the result.
+func (c HealthCheck) checkPaths(ctx Context, baseURL string, cfg *config.HealthEndpointConfig) (CheckResult, error) {
+	paths := cfg.Paths
+	if cfg.Path != "" && !contains(paths, cfg.Path) {
+		paths = append([]string{cfg.Path}, paths...)
+	}
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	var passed, failed, details []string
+	for _, path := range paths {
+		status, desc := c.probe(ctx, baseURL+path)
+		details = append(details, fmt.Sprintf("%s: %s", path, desc))
+		switch {
+		case status == http.StatusOK:
+			passed = append(passed, path)
+		case status == 0:
+			failed = append(failed, path+" (unreachable)")
+		default:
+			failed = append(failed, fmt.Sprintf("%s (%d)", path, status))
+		}
+	}
+
+	if !cfg.SkipCommonPaths {
+		for _, path := range commonHealthPaths {
+			if contains(paths, path) {
+				continue
+			}
+			if status, desc := c.probe(ctx, baseURL+path); status == http.StatusOK {
+				details = append(details, fmt.Sprintf("%s: %s (not configured)", path, desc))
+			}
+		}
+	}
+
+	if len(failed) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("%d of %d health endpoints failing: %s", len(failed), len(paths), strings.Join(failed, ", ")),
+			Suggestions: []string{
+				"Ensure each endpoint returns 200 when the app is ready",
+				"Check the health paths in preflight.yml",
+			},
+			Details: details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  fmt.Sprintf("All %d health endpoints returned 200: %s", len(passed), strings.Join(passed, ", ")),
+		Details:  details,
+	}, nil
+}
+
+// probe requests url and returns its status (0 on error) and a short
+// description like "200 (42ms)" or the error
+func (c HealthCheck) probe(ctx Context, url string) (int, string) {
+	start := time.Now()
+	resp, _, err := tryURL(ctx.Client, url)
+	elapsed := time.Since(start).Milliseconds()
+	if err != nil {
+		return 0, err.Error()
+	}
+	resp.Body.Close()
+	return resp.StatusCode, fmt.Sprintf("%d (%dms)", resp.StatusCode, elapsed)
 }
 
 // checkPath tries a specific path on all base URLs
 // allowAnySuccess: if true, accept 2xx and 3xx status codes (for root URL check)
 func (c HealthCheck) checkPath(ctx Context, baseURLs []string, path string, configured bool, allowAnySuccess bool) (CheckResult, error) {
 	var lastErr error
+	var probes []string
 	for _, baseURL := range baseURLs {
 		// Handle trailing slash in base URL to avoid double slashes
 		baseURL = strings.TrimSuffix(baseURL, "/")
 		url := baseURL + path
+		start := time.Now()
 		resp, actualURL, err := tryURL(ctx.Client, url)
+		elapsed := time.Since(start).Milliseconds()
 		if err != nil {
 			lastErr = err
+			if ctx.Verbose {
+				probes = append(probes, fmt.Sprintf("Probed %s: %v", url, err))
+			}
 			continue
 		}
 		defer resp.Body.Close()
@@ -83,7 +189,7 @@ func (c HealthCheck) checkPath(ctx Context, baseURLs []string, path string, conf
 			if path != "/" {
 				msg = fmt.Sprintf("Health endpoint at %s returned %d", actualURL, resp.StatusCode)
 			}
-			var details []string
+			details := append(probes, fmt.Sprintf("Response time: %dms", elapsed))
 			if ctx.Verbose && !configured && path != "/" {
 				details = append(details, "Auto-detected health endpoint")
 			}
@@ -97,6 +203,9 @@ func (c HealthCheck) checkPath(ctx Context, baseURLs []string, path string, conf
 			}, nil
 		}
 		lastErr = fmt.Errorf("returned status %d", resp.StatusCode)
+		if ctx.Verbose {
+			probes = append(probes, fmt.Sprintf("Probed %s: %d (%dms)", actualURL, resp.StatusCode, elapsed))
+		}
 	}
 
 	// Only return failure for configured paths or root fallback
@@ -116,11 +225,13 @@ func (c HealthCheck) checkPath(ctx Context, baseURLs []string, path string, conf
 			Passed:      false,
 			Message:     fmt.Sprintf("Site unreachable: %v", lastErr),
 			Suggestions: suggestions,
+			Details:     probes,
 		}, nil
 	}
 
 	// Return non-passed for auto-detection probes (will continue to next path)
 	return CheckResult{
-		Passed: false,
+		Passed:  false,
+		Details: probes,
 	}, nil
 }
@@ -0,0 +1,184 @@
+package checks
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// HreflangCheck validates <link rel="alternate" hreflang> tags on sites that
+// declare multiple language versions
+type HreflangCheck struct{}
+
+func (c HreflangCheck) ID() string {
+	return "hreflang"
+}
+
+func (c HreflangCheck) Title() string {
+	return "hreflang tags"
+}
+
+func (c HreflangCheck) RunsFromPage() bool {
+	return true
+}
+
+var (
+	linkTagPattern  = regexp.MustCompile(`(?is)<link\s[^>]*>`)
+	hreflangPattern = regexp.MustCompile(`(?i)\bhreflang\s*=\s*["']([^"']*)["']`)
+	hrefPattern     = regexp.MustCompile(`(?i)\bhref\s*=\s*["']([^"']*)["']`)
+	relAltPattern   = regexp.MustCompile(`(?i)\brel\s*=\s*["']alternate["']`)
+)
+
+// maxReciprocalFetches caps how many alternate pages are fetched
+const maxReciprocalFetches = 10
+
+// hreflangLink is one alternate language version declared by a page
+type hreflangLink struct {
+	Lang string
+	Href string
+}
+
+func (c HreflangCheck) Run(ctx Context) (CheckResult, error) {
+	var problems, details []string
+	var remote *pageSource
+	var remoteLinks []hreflangLink
+	found := false
+	hasDefault := false
+
+	sources := collectPageSources(ctx)
+	for i, source := range sources {
+		links := parseHreflangLinks(source.Content)
+		if len(links) == 0 {
+			continue
+		}
+		found = true
+		if strings.HasPrefix(source.Name, "http") {
+			remote = &sources[i]
+			remoteLinks = links
+		}
+
+		for _, link := range links {
+			details = append(details, fmt.Sprintf("%s: %s → %s", source.Name, link.Lang, link.Href))
+			lang := strings.ToLower(link.Lang)
+			if lang == "x-default" {
+				hasDefault = true
+				continue
+			}
+			// Template expressions are filled in at render time
+			if isTemplatePlaceholder(link.Lang) {
+				continue
+			}
+			if !isValidLangTag(link.Lang) {
+				problems = append(problems, fmt.Sprintf("Invalid hreflang %q in %s%s", link.Lang, source.Name, langTagHint(link.Lang)))
+			}
+		}
+	}
+
+	// Single-language sites don't need hreflang
+	if !found {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No hreflang tags, skipping",
+		}, nil
+	}
+
+	if !hasDefault {
+		problems = append(problems, `No hreflang="x-default" fallback declared`)
+	}
+
+	// Every alternate must link back to the page, or search engines ignore the pair
+	if remote != nil {
+		problems = append(problems, checkReciprocal(ctx.Client, remote.Name, remoteLinks)...)
+	}
+
+	if len(problems) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  problems[0],
+			Suggestions: append(problems[1:],
+				`Use BCP-47 codes such as en, en-GB, or pt-BR, plus hreflang="x-default"`,
+				"Each language version must list all others, including itself",
+			),
+			Details: details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "hreflang tags are valid",
+		Details:  details,
+	}, nil
+}
+
+// parseHreflangLinks returns the rel="alternate" links with an hreflang
+func parseHreflangLinks(content string) []hreflangLink {
+	var links []hreflangLink
+	for _, tag := range linkTagPattern.FindAllString(content, -1) {
+		if !relAltPattern.MatchString(tag) {
+			continue
+		}
+		lang := hreflangPattern.FindStringSubmatch(tag)
+		href := hrefPattern.FindStringSubmatch(tag)
+		if lang == nil || href == nil {
+			continue
+		}
+		links = append(links, hreflangLink{Lang: strings.TrimSpace(lang[1]), Href: strings.TrimSpace(href[1])})
+	}
+	return links
+}
+
+// checkReciprocal fetches each alternate of pageURL and reports those that
+// don't link back to it
+func checkReciprocal(client *http.Client, pageURL string, links []hreflangLink) []string {
+	var problems []string
+	fetched := 0
+	for _, link := range links {
+		if !strings.HasPrefix(link.Href, "http") || sameURL(link.Href, pageURL) {
+			continue
+		}
+		if fetched >= maxReciprocalFetches {
+			break
+		}
+		fetched++
+
+		resp, err := doGet(client, link.Href)
+		if err != nil {
+			continue
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			problems = append(problems, fmt.Sprintf("hreflang %s alternate %s returned %d", link.Lang, link.Href, resp.StatusCode))
+			continue
+		}
+
+		reciprocal := false
+		for _, back := range parseHreflangLinks(string(body)) {
+			if sameURL(back.Href, pageURL) {
+				reciprocal = true
+				break
+			}
+		}
+		if !reciprocal {
+			problems = append(problems, fmt.Sprintf("hreflang %s alternate %s doesn't link back to %s", link.Lang, link.Href, pageURL))
+		}
+	}
+	return problems
+}
+
+// sameURL compares URLs ignoring a trailing slash
+func sameURL(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
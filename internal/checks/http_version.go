@@ -0,0 +1,122 @@
+package checks
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// HTTPVersionCheck verifies the production site negotiates HTTP/2 and
+// advertises HTTP/3
+type HTTPVersionCheck struct{}
+
+func (c HTTPVersionCheck) ID() string {
+	return "http_version"
+}
+
+func (c HTTPVersionCheck) Title() string {
+	return "HTTP/2 & HTTP/3"
+}
+
+func (c HTTPVersionCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c HTTPVersionCheck) DependsOn() []string {
+	return siteDependencies
+}
+
+func (c HTTPVersionCheck) Run(ctx Context) (CheckResult, error) {
+	if ctx.Config.URLs.Production == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No production URL configured",
+		}, nil
+	}
+
+	parsedURL, err := url.Parse(ctx.Config.URLs.Production)
+	if err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "Invalid production URL",
+		}, nil
+	}
+
+	// HTTP/2 negotiation requires TLS; the SSL check already flags plain HTTP
+	if parsedURL.Scheme != "https" || isLocalURL(parsedURL.Hostname()) {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "Skipped for non-HTTPS or local URL",
+		}, nil
+	}
+
+	// Ask through the scan's client, which goes through the configured proxy
+	// and offers h2 (ForceAttemptHTTP2), and see what the server picked.
+	// HTTP/3 is advertised through the Alt-Svc response header.
+	resp, err := doGet(ctx.Client, ctx.Config.URLs.Production)
+	if err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "Could not reach production URL, skipping",
+			Details:  []string{fmt.Sprintf("%s: %v", ctx.Config.URLs.Production, err)},
+		}, nil
+	}
+	altSvc := resp.Header.Get("Alt-Svc")
+	resp.Body.Close()
+
+	details := []string{"Negotiated: " + resp.Proto}
+	if altSvc != "" {
+		details = append(details, "Alt-Svc: "+altSvc)
+	}
+	hasH3 := strings.Contains(altSvc, "h3")
+
+	if resp.ProtoMajor < 2 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "Only HTTP/1.1 is available over TLS",
+			Suggestions: []string{
+				"Enable HTTP/2 on your web server, load balancer, or CDN",
+				"Most CDNs (Cloudflare, Fastly, CloudFront) enable HTTP/2 and HTTP/3 by default",
+			},
+			Details: details,
+		}, nil
+	}
+
+	if !hasH3 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  "HTTP/2 supported (HTTP/3 not advertised)",
+			Details:  details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "HTTP/2 and HTTP/3 supported",
+		Details:  details,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package checks
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/preflightsh/preflight/internal/config"
+)
+
+// httpVersionContext points the check at server under the name example.com,
+// which the test certificate covers and isLocalURL doesn't skip
+func httpVersionContext(t *testing.T, server *httptest.Server) Context {
+	t.Helper()
+	transport := server.Client().Transport.(*http.Transport).Clone()
+	addr := server.Listener.Addr().String()
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, addr)
+	}
+	_, port, _ := net.SplitHostPort(addr)
+	cfg := &config.PreflightConfig{}
+	cfg.URLs.Production = "https://example.com:" + port
+	return Context{Context: context.Background(), Config: cfg, Client: &http.Client{Transport: transport}}
+}
+
+func TestHTTPVersion(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Alt-Svc", `h3=":443"; ma=86400`)
+	})
+
+	h2 := httptest.NewUnstartedServer(handler)
+	h2.EnableHTTP2 = true
+	h2.StartTLS()
+	defer h2.Close()
+
+	result, err := HTTPVersionCheck{}.Run(httpVersionContext(t, h2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Passed || result.Message != "HTTP/2 and HTTP/3 supported" {
+		t.Errorf("h2 server = %+v, want HTTP/2 and HTTP/3", result)
+	}
+
+	h1 := httptest.NewTLSServer(handler)
+	defer h1.Close()
+
+	result, err = HTTPVersionCheck{}.Run(httpVersionContext(t, h1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Passed || result.Severity != SeverityWarn {
+		t.Errorf("HTTP/1.1 server = %+v, want a warning", result)
+	}
+}
+
+func TestHTTPVersionUnreachableIsSkipped(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	ctx := httpVersionContext(t, server)
+	server.Close()
+
+	result, err := HTTPVersionCheck{}.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Skipped || !strings.Contains(result.Message, "skipping") {
+		t.Errorf("unreachable server = %+v, want a skipped result", result)
+	}
+}
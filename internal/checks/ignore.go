@@ -0,0 +1,225 @@
+package checks
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// DefaultSkipDirs are directory names skipped by every file walk unless the
+// config overrides them with skipDirs
+var DefaultSkipDirs = []string{
+	"node_modules",
+	"vendor",
+	".git",
+	"dist",
+	"build",
+	".next",
+	".nuxt",
+	".svelte-kit",
+	".turbo",
+	".vercel",
+	".netlify",
+	".cache",
+	"coverage",
+	"tmp",
+	"__pycache__",
+	".venv",
+	"venv",
+}
+
+// ignoreFileNames are read from the project root, in order
+var ignoreFileNames = []string{".gitignore", ".preflightignore"}
+
+// ignoreRule is one parsed line of an ignore file
+type ignoreRule struct {
+	pattern *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// ignoreRules decides which paths file walks should skip for one project
+type ignoreRules struct {
+	skipDirs map[string]bool
+	rules    []ignoreRule
+}
+
+var (
+	ignoreMu    sync.Mutex
+	ignoreCache = make(map[string]*ignoreRules)
+)
+
+// LoadIgnoreRules reads .gitignore and .preflightignore from rootDir and sets
+// the directory names to skip. A nil or empty skipDirs uses DefaultSkipDirs.
+func LoadIgnoreRules(rootDir string, skipDirs []string) {
+	if len(skipDirs) == 0 {
+		skipDirs = DefaultSkipDirs
+	}
+
+	rules := &ignoreRules{skipDirs: make(map[string]bool)}
+	for _, name := range skipDirs {
+		rules.skipDirs[strings.Trim(name, "/")] = true
+	}
+	for _, name := range ignoreFileNames {
+		rules.rules = append(rules.rules, parseIgnoreFile(filepath.Join(rootDir, name))...)
+	}
+
+	ignoreMu.Lock()
+	ignoreCache[filepath.Clean(rootDir)] = rules
+	ignoreMu.Unlock()
+}
+
+// rulesFor returns the ignore rules for rootDir, loading defaults on first use
+func rulesFor(rootDir string) *ignoreRules {
+	key := filepath.Clean(rootDir)
+	ignoreMu.Lock()
+	rules, ok := ignoreCache[key]
+	ignoreMu.Unlock()
+	if !ok {
+		LoadIgnoreRules(rootDir, nil)
+		ignoreMu.Lock()
+		rules = ignoreCache[key]
+		ignoreMu.Unlock()
+	}
+	return rules
+}
+
+// shouldSkip reports whether a walked path should be ignored. Walkers return
+// filepath.SkipDir for skipped directories and nil for skipped files.
+func shouldSkip(rootDir, path string, isDir bool) bool {
+	relPath, err := filepath.Rel(rootDir, path)
+	if err != nil || relPath == "." || strings.HasPrefix(relPath, "..") {
+		return false
+	}
+
+	rules := rulesFor(rootDir)
+	if isDir && rules.skipDirs[filepath.Base(path)] {
+		return true
+	}
+	return matchIgnoreRules(rules.rules, filepath.ToSlash(relPath), isDir)
+}
+
+// matchIgnoreRules reports whether rules ignore the slash-separated relPath
+func matchIgnoreRules(rules []ignoreRule, relPath string, isDir bool) bool {
+	// Later rules override earlier ones, as in git
+	skip := false
+	for _, rule := range rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		if rule.pattern.MatchString(relPath) {
+			skip = !rule.negate
+		}
+	}
+	return skip
+}
+
+// parseIgnoreFile reads gitignore-style rules. Only the root file is used;
+// nested .gitignore files are not consulted.
+func parseIgnoreFile(path string) []ignoreRule {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var rules []ignoreRule
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := ignoreRule{}
+		if strings.HasPrefix(line, "!") {
+			rule.negate = true
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+
+		// Patterns containing a slash are relative to the root; others match at any depth
+		anchored := strings.Contains(line, "/")
+		line = strings.TrimPrefix(line, "/")
+		if line == "" {
+			continue
+		}
+
+		expr := globToRegexp(line)
+		if !anchored {
+			expr = "(.*/)?" + expr
+		}
+		pattern, err := regexp.Compile("^" + expr + "$")
+		if err != nil {
+			continue
+		}
+		rule.pattern = pattern
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// matchesPathGlob reports whether the slash-separated relPath matches a
+// gitignore-style glob, or is inside a directory that does. As in
+// .gitignore, a glob with a slash is relative to the root and one without
+// matches at any depth.
+func matchesPathGlob(glob, relPath string) bool {
+	anchored := strings.Contains(strings.TrimSuffix(glob, "/"), "/")
+	glob = strings.Trim(glob, "/")
+	if glob == "" {
+		return false
+	}
+	expr := globToRegexp(glob)
+	if !anchored {
+		expr = "(.*/)?" + expr
+	}
+	pattern, err := regexp.Compile("^" + expr + "(/.*)?$")
+	return err == nil && pattern.MatchString(relPath)
+}
+
+// globToRegexp converts a gitignore glob to a regular expression
+func globToRegexp(glob string) string {
+	var b strings.Builder
+	for i := 0; i < len(glob); i++ {
+		ch := glob[i]
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			b.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "/**"):
+			b.WriteString("(/.*)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case ch == '*':
+			b.WriteString("[^/]*")
+		case ch == '?':
+			b.WriteString("[^/]")
+		case ch == '[':
+			end := strings.IndexByte(glob[i:], ']')
+			if end < 0 {
+				b.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end
+		case ch == '\\' && i+1 < len(glob):
+			i++
+			b.WriteString(regexp.QuoteMeta(string(glob[i])))
+		default:
+			b.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	return b.String()
+}
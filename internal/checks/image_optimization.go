@@ -2,8 +2,12 @@ package checks
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -18,20 +22,40 @@ func (c ImageOptimizationCheck) Title() string {
 }
 
 func (c ImageOptimizationCheck) Run(ctx Context) (CheckResult, error) {
-	largeImages := findLargeImages(ctx.RootDir, 500*1024)
+	largeImages := findLargeImages(ctx, 500*1024)
+	oversized, liveDetails := checkServedImages(ctx)
+	legacy, legacyDetails := findLegacyFormatImages(ctx)
+	details := append(liveDetails, legacyDetails...)
 
-	if len(largeImages) == 0 {
+	if len(largeImages) == 0 && len(oversized) == 0 {
+		// JPEG/PNG without a modern alternative is worth knowing about but
+		// shouldn't block a launch
+		if len(legacy) > 0 {
+			return CheckResult{
+				ID:       c.ID(),
+				Title:    c.Title(),
+				Severity: SeverityInfo,
+				Passed:   false,
+				Message:  fmt.Sprintf("%d image(s) could be served as WebP or AVIF", len(legacy)),
+				Suggestions: []string{
+					"Convert JPEG/PNG images to WebP or AVIF (typically 25-50% smaller)",
+					"Offer them with <picture><source type=\"image/avif\" ...> or srcset, keeping the JPEG/PNG as fallback",
+				},
+				Details: details,
+			}, nil
+		}
 		return CheckResult{
 			ID:       c.ID(),
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
 			Message:  "No large images found",
+			Details:  details,
 		}, nil
 	}
 
 	maxShow := 5
-	var suggestions []string
+	var suggestions, problems []string
 	for i, img := range largeImages {
 		if i >= maxShow {
 			suggestions = append(suggestions, fmt.Sprintf("... and %d more", len(largeImages)-maxShow))
@@ -39,23 +63,198 @@ func (c ImageOptimizationCheck) Run(ctx Context) (CheckResult, error) {
 		}
 		suggestions = append(suggestions, fmt.Sprintf("%s (%s)", img.path, formatSize(img.size)))
 	}
+	if len(largeImages) > 0 {
+		problems = append(problems, fmt.Sprintf("Found %d large image(s) over 500KB", len(largeImages)))
+	}
+	if len(oversized) > 0 {
+		problems = append(problems, fmt.Sprintf("%d homepage image(s) are heavy for their dimensions", len(oversized)))
+		suggestions = append(suggestions,
+			"Resize images to the size they're displayed at and serve srcset variants",
+			"Re-encode photos as WebP or AVIF, or JPEG at quality 75-85",
+		)
+	} else if len(legacy) > 0 {
+		suggestions = append(suggestions, "Convert JPEG/PNG images to WebP or AVIF (typically 25-50% smaller)")
+	}
 
 	return CheckResult{
 		ID:          c.ID(),
 		Title:       c.Title(),
 		Severity:    SeverityWarn,
 		Passed:      false,
-		Message:     fmt.Sprintf("Found %d large image(s) over 500KB", len(largeImages)),
+		Message:     strings.Join(problems, "; "),
 		Suggestions: suggestions,
+		Details:     details,
 	}, nil
 }
 
+var (
+	pictureBlockPattern = regexp.MustCompile(`(?is)<picture\b.*?</picture>`)
+	modernFormatPattern = regexp.MustCompile(`(?i)image/(webp|avif)|\.(webp|avif)\b`)
+	imgSrcsetPattern    = regexp.MustCompile(`(?i)\bsrcset\s*=\s*\{?\s*["']([^"']*)["']`)
+	legacyImagePattern  = regexp.MustCompile(`(?i)\.(jpe?g|png)$`)
+	nextImagePattern    = regexp.MustCompile(`from\s+["']next/image["']|require\(\s*["']next/image["']\s*\)`)
+)
+
+// findLegacyFormatImages lists <img> tags in the layouts and live homepage
+// that load a JPEG or PNG with no WebP/AVIF alternative, from a <picture>
+// <source> or srcset. Projects using next/image are skipped, since it
+// negotiates modern formats itself.
+func findLegacyFormatImages(ctx Context) (legacy, details []string) {
+	if searchForPatterns(ctx.RootDir, ctx.Config.Stack, []*regexp.Regexp{nextImagePattern}) {
+		return nil, []string{"next/image in use: images are served as WebP/AVIF automatically"}
+	}
+
+	var seen []string
+	for _, source := range collectPageSources(ctx) {
+		// A <picture> offering a modern format covers the <img> fallback inside it
+		content := pictureBlockPattern.ReplaceAllStringFunc(source.Content, func(block string) string {
+			if modernFormatPattern.MatchString(block) {
+				return ""
+			}
+			return block
+		})
+		for _, tag := range imgTagPattern.FindAllString(content, -1) {
+			m := sriSrcAttr.FindStringSubmatch(tag)
+			if m == nil {
+				continue
+			}
+			src := strings.TrimSpace(m[1])
+			path, _, _ := strings.Cut(src, "?")
+			if !legacyImagePattern.MatchString(path) {
+				continue
+			}
+			if set := imgSrcsetPattern.FindStringSubmatch(tag); set != nil && modernFormatPattern.MatchString(set[1]) {
+				continue
+			}
+			// The layout and the page it renders usually reference the same files
+			if !contains(seen, src) {
+				seen = append(seen, src)
+				legacy = append(legacy, source.Name+": "+src)
+			}
+		}
+	}
+
+	if len(legacy) == 0 {
+		return nil, nil
+	}
+	details = append(details, fmt.Sprintf("%d JPEG/PNG reference(s) without a WebP/AVIF alternative:", len(legacy)))
+	for i, ref := range legacy {
+		if i >= 5 {
+			details = append(details, fmt.Sprintf("  ... and %d more", len(legacy)-5))
+			break
+		}
+		details = append(details, "  "+ref)
+	}
+	return legacy, details
+}
+
+var (
+	imgTagPattern   = regexp.MustCompile(`(?is)<img\b[^>]*>`)
+	imgWidthPattern = regexp.MustCompile(`(?i)\bwidth\s*=\s*["']?(\d+)`)
+)
+
+const (
+	// maxServedImages caps how many homepage images are downloaded
+	maxServedImages = 10
+	// Images below this size are fine whatever their dimensions
+	minOversizedBytes = 200 << 10
+	// Above this, an image is too heavy for a web page at any size
+	maxImageBytes = 1 << 20
+	// Well-compressed photos are well under half a byte per pixel
+	maxBytesPerPixel = 0.5
+)
+
+// checkServedImages fetches the images on the live homepage and returns the
+// ones whose byte size is out of proportion to their dimensions, worst
+// first, with Details describing them
+func checkServedImages(ctx Context) (oversized, details []string) {
+	page, ok := fetchHomepage(ctx)
+	if !ok {
+		return nil, nil
+	}
+	base, err := url.Parse(page.Name)
+	if err != nil {
+		return nil, nil
+	}
+
+	type heavyImage struct {
+		url  string
+		size int64
+		note string
+	}
+	var heavy []heavyImage
+	var seen []string
+	checked := 0
+	for _, tag := range imgTagPattern.FindAllString(page.Content, -1) {
+		m := sriSrcAttr.FindStringSubmatch(tag)
+		if m == nil || strings.HasPrefix(m[1], "data:") {
+			continue
+		}
+		ref, err := url.Parse(strings.TrimSpace(m[1]))
+		if err != nil {
+			continue
+		}
+		imageURL := base.ResolveReference(ref).String()
+		if contains(seen, imageURL) || strings.HasSuffix(strings.ToLower(ref.Path), ".svg") {
+			continue
+		}
+		seen = append(seen, imageURL)
+		if len(seen) > maxServedImages {
+			break
+		}
+
+		info, err := fetchImageInfo(ctx, imageURL, true)
+		if err != nil || info.Size < 0 {
+			continue
+		}
+		checked++
+
+		pixels := int64(info.Width) * int64(info.Height)
+		if pixels == 0 {
+			continue
+		}
+		bytesPerPixel := float64(info.Size) / float64(pixels)
+		note := fmt.Sprintf("%s, %dx%d %s (%.2f bytes/px)", formatSize(info.Size), info.Width, info.Height, info.Format, bytesPerPixel)
+		// More than twice the width attribute is wasted even on 2x screens
+		downscaled := false
+		if m := imgWidthPattern.FindStringSubmatch(tag); m != nil {
+			if displayed, _ := strconv.Atoi(m[1]); displayed > 0 && info.Width > 2*displayed {
+				downscaled = true
+				note += fmt.Sprintf(", displayed at width %d", displayed)
+			}
+		}
+		if info.Size >= maxImageBytes || (info.Size >= minOversizedBytes && (bytesPerPixel > maxBytesPerPixel || downscaled)) {
+			if info.Format == "png" {
+				note += ", consider WebP/AVIF"
+			}
+			heavy = append(heavy, heavyImage{imageURL, info.Size, note})
+		}
+	}
+
+	if checked == 0 {
+		return nil, nil
+	}
+	sort.Slice(heavy, func(i, j int) bool { return heavy[i].size > heavy[j].size })
+	for i, img := range heavy {
+		oversized = append(oversized, img.url)
+		if i < 5 {
+			details = append(details, img.url+": "+img.note)
+		}
+	}
+	if len(heavy) > 5 {
+		details = append(details, fmt.Sprintf("... and %d more", len(heavy)-5))
+	}
+	details = append(details, fmt.Sprintf("Checked %d homepage image(s)", checked))
+	return oversized, details
+}
+
 type largeImage struct {
 	path string
 	size int64
 }
 
-func findLargeImages(rootDir string, threshold int64) []largeImage {
+func findLargeImages(ctx Context, threshold int64) []largeImage {
+	rootDir := ctx.RootDir
 	var images []largeImage
 
 	webRoots := []string{"public", "static", "web", "www", "dist", "build", "_site", "out", "assets"}
@@ -64,30 +263,34 @@ func findLargeImages(rootDir string, threshold int64) []largeImage {
 		".webp": true, ".svg": true, ".bmp": true, ".tiff": true,
 	}
 
+	// Craft CMS asset caches, in addition to the shared skip rules
 	skipDirs := map[string]bool{
-		"node_modules": true,
-		"vendor":       true,
-		".git":         true,
-		"cpresources":  true,
+		"cpresources": true,
 	}
 
-	for _, webRoot := range webRoots {
-		rootPath := filepath.Join(rootDir, webRoot)
+	for _, rootPath := range scopedDirs(rootDir, webRoots) {
 		if _, err := os.Stat(rootPath); os.IsNotExist(err) {
 			continue
 		}
 
 		filepath.WalkDir(rootPath, func(path string, d os.DirEntry, err error) error {
+			if ctx.Err() != nil {
+				return ctx.Err() // the scan deadline ran out
+			}
 			if err != nil {
 				return nil
 			}
 
 			if d.IsDir() {
-				if skipDirs[d.Name()] {
+				// The web root itself may be a build directory such as dist
+				if skipDirs[d.Name()] || (path != rootPath && shouldSkip(rootDir, path, true)) {
 					return filepath.SkipDir
 				}
 				return nil
 			}
+			if shouldSkip(rootDir, path, false) {
+				return nil
+			}
 
 			ext := strings.ToLower(filepath.Ext(path))
 			if !imageExts[ext] {
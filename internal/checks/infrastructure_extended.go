@@ -23,6 +23,7 @@ func (c RabbitMQCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "RabbitMQ not declared, skipping",
 		}, nil
 	}
@@ -88,6 +89,7 @@ func (c ElasticsearchCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Elasticsearch not declared, skipping",
 		}, nil
 	}
@@ -153,6 +155,7 @@ func (c ConvexCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Convex not declared, skipping",
 		}, nil
 	}
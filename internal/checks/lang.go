@@ -1,9 +1,12 @@
 package checks
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 )
 
 type LangAttributeCheck struct{}
@@ -32,6 +35,7 @@ func (c LangAttributeCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "No layout file found, skipping",
 		}, nil
 	}
@@ -44,101 +48,154 @@ func (c LangAttributeCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityWarn,
 			Passed:   false,
-			Message:  "Could not read layout file: " + cfg.MainLayout,
+			Message:  "Could not read layout file: " + layoutFile,
 		}, nil
 	}
 
-	contentStr := string(content)
+	// Find lang on the layout's <html> tag, or in a common layout partial
+	source := layoutFile
+	value, found := findLangValue(string(content))
+	if !found {
+		source, value, found = checkLangPartials(ctx.RootDir)
+	}
+	if !found {
+		return CheckResult{
+			ID:          c.ID(),
+			Title:       c.Title(),
+			Severity:    SeverityWarn,
+			Passed:      false,
+			Message:     "No lang attribute on <html> tag",
+			Suggestions: getLangSuggestions(ctx.Config.Stack),
+		}, nil
+	}
+	details := []string{fmt.Sprintf("%s: lang=%q", source, value)}
+
+	// Templates may set lang dynamically; the rendered page shows whether it worked
+	baseURL := ctx.Config.URLs.Production
+	if baseURL == "" {
+		baseURL = ctx.Config.URLs.Staging
+	}
+	if baseURL != "" {
+		if resp, actualURL, err := tryURL(ctx.Client, withBasePath(ctx, baseURL)); err == nil {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+			resp.Body.Close()
+			liveValue, liveFound := findLangValue(string(body))
+			if !liveFound {
+				return CheckResult{
+					ID:          c.ID(),
+					Title:       c.Title(),
+					Severity:    SeverityWarn,
+					Passed:      false,
+					Message:     "Rendered homepage has no lang attribute on <html>",
+					Suggestions: getLangSuggestions(ctx.Config.Stack),
+					Details:     append(details, actualURL+": no lang attribute"),
+				}, nil
+			}
+			source, value = actualURL, liveValue
+			details = append(details, fmt.Sprintf("%s: lang=%q", source, value))
+
+			if isTemplatePlaceholder(value) {
+				return CheckResult{
+					ID:       c.ID(),
+					Title:    c.Title(),
+					Severity: SeverityWarn,
+					Passed:   false,
+					Message:  fmt.Sprintf("Rendered homepage has an unrendered lang placeholder: %s", value),
+					Suggestions: []string{
+						"Check the template variable that sets lang is defined when the page renders",
+					},
+					Details: details,
+				}, nil
+			}
+		}
+	}
 
-	// Check for lang attribute on html tag
-	if hasLangAttribute(contentStr, ctx.Config.Stack) {
+	// Dynamic values in a template are filled in at render time
+	if isTemplatePlaceholder(value) {
 		return CheckResult{
 			ID:       c.ID(),
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
-			Message:  "HTML lang attribute set",
+			Message:  "HTML lang attribute set dynamically",
+			Details:  details,
+		}, nil
+	}
+
+	if strings.TrimSpace(value) == "" {
+		return CheckResult{
+			ID:          c.ID(),
+			Title:       c.Title(),
+			Severity:    SeverityWarn,
+			Passed:      false,
+			Message:     "HTML lang attribute is empty in " + source,
+			Suggestions: getLangSuggestions(ctx.Config.Stack),
+			Details:     details,
 		}, nil
 	}
 
-	// Check common layout partials
-	if checkLangPartials(ctx.RootDir, ctx.Config.Stack) {
+	if !isValidLangTag(value) {
 		return CheckResult{
 			ID:       c.ID(),
 			Title:    c.Title(),
-			Severity: SeverityInfo,
-			Passed:   true,
-			Message:  "HTML lang attribute set (in partial)",
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("HTML lang %q is not a valid language code%s", value, langTagHint(value)),
+			Suggestions: []string{
+				"Use a BCP-47 language tag such as en, en-US, or pt-BR",
+			},
+			Details: details,
 		}, nil
 	}
 
 	return CheckResult{
 		ID:       c.ID(),
 		Title:    c.Title(),
-		Severity: SeverityWarn,
-		Passed:   false,
-		Message:  "No lang attribute on <html> tag",
-		Suggestions: getLangSuggestions(ctx.Config.Stack),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  fmt.Sprintf("HTML lang attribute set (%s)", value),
+		Details:  details,
 	}, nil
 }
 
-func hasLangAttribute(content, stack string) bool {
-	// Strip comments to avoid false positives on commented-out code
-	content = stripCommentsLang(content)
-
-	// Standard HTML lang attribute: <html lang="en">
-	htmlLang := regexp.MustCompile(`(?i)<html[^>]+lang=["'][a-z]{2}(-[A-Za-z]{2,})?["']`)
-	if htmlLang.MatchString(content) {
-		return true
-	}
-
-	// Dynamic lang attribute with template variable
-	dynamicLang := regexp.MustCompile(`(?i)<html[^>]+lang=["']\{`)
-	if dynamicLang.MatchString(content) {
-		return true
-	}
-
+var (
+	// <html lang="..."> or <html lang='...'>, including Next.js <Html>
+	htmlLangQuoted = regexp.MustCompile(`(?is)<html\b[^>]*?\blang\s*=\s*(?:"([^"]*)"|'([^']*)')`)
 	// JSX/TSX: <html lang={...}>
-	jsxLang := regexp.MustCompile(`(?i)<html[^>]+lang=\{`)
-	if jsxLang.MatchString(content) {
-		return true
-	}
-
-	// ERB: <html lang="<%= ... %>">
-	erbLang := regexp.MustCompile(`(?i)<html[^>]+lang=["']<%=`)
-	if erbLang.MatchString(content) {
-		return true
-	}
+	htmlLangJSX = regexp.MustCompile(`(?is)<html\b[^>]*?\blang\s*=\s*(\{[^}]*\})`)
+	// JSX string literal: lang={"en"}
+	jsxStringLiteral = regexp.MustCompile(`^\{\s*["'\x60]([^"'\x60]*)["'\x60]\s*\}$`)
+	// Next.js App Router: lang set in RootLayout props or config
+	nextLangProp = regexp.MustCompile(`(?i)\blang[:=]\s*["']([a-z]{2}[^"']*)["']`)
+)
 
-	// Blade: <html lang="{{ ... }}">
-	bladeLang := regexp.MustCompile(`(?i)<html[^>]+lang=["']\{\{`)
-	if bladeLang.MatchString(content) {
-		return true
-	}
+// findLangValue returns the lang value on the <html> tag. Template
+// expressions are returned as written.
+func findLangValue(content string) (string, bool) {
+	// Strip comments to avoid false positives on commented-out code
+	content = stripCommentsLang(content)
 
-	// Twig/Jinja: <html lang="{{ ... }}">
-	twigLang := regexp.MustCompile(`(?i)<html[^>]+lang=["']\{\{`)
-	if twigLang.MatchString(content) {
-		return true
+	if m := htmlLangJSX.FindStringSubmatch(content); m != nil {
+		if lit := jsxStringLiteral.FindStringSubmatch(m[1]); lit != nil {
+			return lit[1], true
+		}
+		return m[1], true
 	}
-
-	// Hugo: {{ with .Site.Language.Lang }}
-	hugoLang := regexp.MustCompile(`(?i)<html[^>]+lang=["']\{\{.*\.Language`)
-	if hugoLang.MatchString(content) {
-		return true
+	if m := htmlLangQuoted.FindStringSubmatch(content); m != nil {
+		if m[1] != "" {
+			return m[1], true
+		}
+		return m[2], true
 	}
-
-	// Next.js App Router - lang is set in RootLayout
-	// Check for lang prop or hardcoded lang
-	nextjsLang := regexp.MustCompile(`(?i)lang[:=]\s*["'][a-z]{2}`)
-	if nextjsLang.MatchString(content) {
-		return true
+	if m := nextLangProp.FindStringSubmatch(content); m != nil {
+		return m[1], true
 	}
-
-	return false
+	return "", false
 }
 
-func checkLangPartials(rootDir, stack string) bool {
+// checkLangPartials looks for a lang attribute in common layout files and
+// returns the file and value found
+func checkLangPartials(rootDir string) (string, string, bool) {
 	// Common locations for layout files that contain <html> tag
 	layoutPaths := []string{
 		// Generic
@@ -187,18 +244,18 @@ func checkLangPartials(rootDir, stack string) bool {
 		"src/components/layout.tsx",
 	}
 
-	for _, layoutPath := range layoutPaths {
+	for _, layoutPath := range scopedPaths(rootDir, layoutPaths) {
 		fullPath := filepath.Join(rootDir, layoutPath)
 		content, err := os.ReadFile(fullPath)
 		if err != nil {
 			continue
 		}
-		if hasLangAttribute(string(content), stack) {
-			return true
+		if value, ok := findLangValue(string(content)); ok {
+			return layoutPath, value, true
 		}
 	}
 
-	return false
+	return "", "", false
 }
 
 func getLangSuggestions(stack string) []string {
@@ -268,3 +325,32 @@ func stripCommentsLang(content string) string {
 
 	return content
 }
+
+// langTagPattern matches the common BCP-47 shapes: language, optional
+// script, optional region (en, zh-Hant, en-GB, es-419)
+var langTagPattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z]{4})?(-([A-Za-z]{2}|[0-9]{3}))?$`)
+
+// templatePlaceholderPattern matches template expressions left in a value
+var templatePlaceholderPattern = regexp.MustCompile(`\{\{|\{%|<%|\$\{|^\{|\[\[`)
+
+// isValidLangTag reports whether tag is a well-formed BCP-47 language tag
+func isValidLangTag(tag string) bool {
+	return langTagPattern.MatchString(tag)
+}
+
+// isTemplatePlaceholder reports whether a value is a template expression
+// rather than a literal
+func isTemplatePlaceholder(value string) bool {
+	return templatePlaceholderPattern.MatchString(value)
+}
+
+// langTagHint suggests a fix for common language tag mistakes
+func langTagHint(tag string) string {
+	if strings.Contains(tag, "_") {
+		return fmt.Sprintf(" (use %q)", strings.ReplaceAll(tag, "_", "-"))
+	}
+	if strings.HasSuffix(strings.ToLower(tag), "-uk") {
+		return fmt.Sprintf(" (use %q; UK is not a region code)", tag[:len(tag)-2]+"GB")
+	}
+	return ""
+}
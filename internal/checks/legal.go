@@ -1,7 +1,6 @@
 package checks
 
 import (
-	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -18,6 +17,10 @@ func (c LegalPagesCheck) Title() string {
 	return "Privacy & Terms pages"
 }
 
+func (c LegalPagesCheck) RunsFromPage() bool {
+	return true
+}
+
 func (c LegalPagesCheck) Run(ctx Context) (CheckResult, error) {
 	hasPrivacy := false
 	hasTerms := false
@@ -29,13 +32,11 @@ func (c LegalPagesCheck) Run(ctx Context) (CheckResult, error) {
 		baseURL = ctx.Config.URLs.Production
 	}
 
-	if baseURL != "" {
-		client := &http.Client{
-			Timeout: 5 * time.Second,
-			CheckRedirect: func(req *http.Request, via []*http.Request) error {
-				return http.ErrUseLastResponse // Don't follow redirects
-			},
-		}
+	if baseURL != "" && !ctx.Offline() {
+		baseURL = strings.TrimSuffix(withBasePath(ctx, baseURL), "/")
+		// Don't follow redirects: many sites send unknown paths to the homepage
+		client := noRedirectClient(ctx.Client)
+		client.Timeout = 5 * time.Second
 
 		privacyURLs := []string{
 			"/privacy", "/privacy-policy", "/privacypolicy",
@@ -48,7 +49,7 @@ func (c LegalPagesCheck) Run(ctx Context) (CheckResult, error) {
 			if hasPrivacy {
 				break
 			}
-			resp, err := client.Get(baseURL + path)
+			resp, err := doGet(client, baseURL+path)
 			if err == nil {
 				resp.Body.Close()
 				if resp.StatusCode >= 200 && resp.StatusCode < 400 {
@@ -69,7 +70,7 @@ func (c LegalPagesCheck) Run(ctx Context) (CheckResult, error) {
 			if hasTerms {
 				break
 			}
-			resp, err := client.Get(baseURL + path)
+			resp, err := doGet(client, baseURL+path)
 			if err == nil {
 				resp.Body.Close()
 				if resp.StatusCode >= 200 && resp.StatusCode < 400 {
@@ -203,26 +204,30 @@ func (c LegalPagesCheck) Run(ctx Context) (CheckResult, error) {
 	// Only match actual page files, not utilities like "privacy-settings.tsx" or "usePrivacy.ts"
 	if !hasPrivacy || !hasTerms {
 		flexSearchDirs := []string{"app", "src", "pages", "views", "templates", "content"}
-		for _, dir := range flexSearchDirs {
+		for _, dirPath := range scopedDirs(ctx.RootDir, flexSearchDirs) {
 			if hasPrivacy && hasTerms {
 				break
 			}
-			dirPath := filepath.Join(ctx.RootDir, dir)
 			if _, err := os.Stat(dirPath); err != nil {
 				continue
 			}
 			filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+				if ctx.Err() != nil {
+					return ctx.Err() // the scan deadline ran out
+				}
 				if err != nil || (hasPrivacy && hasTerms) {
 					return nil
 				}
-				// Skip node_modules, vendor, etc.
+				// Skip dependency, build, and ignored paths
 				if info.IsDir() {
-					name := info.Name()
-					if name == "node_modules" || name == "vendor" || name == ".git" || name == "dist" || name == "build" {
+					if shouldSkip(ctx.RootDir, path, true) {
 						return filepath.SkipDir
 					}
 					return nil
 				}
+				if shouldSkip(ctx.RootDir, path, false) {
+					return nil
+				}
 
 				nameLower := strings.ToLower(info.Name())
 				relPath, _ := filepath.Rel(ctx.RootDir, path)
@@ -1,8 +1,11 @@
 package checks
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 )
 
@@ -37,24 +40,7 @@ func (c LicenseCheck) Run(ctx Context) (CheckResult, error) {
 			if content, err := os.ReadFile(fullPath); err == nil {
 				contentStr := strings.TrimSpace(string(content))
 				if len(contentStr) > 0 {
-					// Try to detect license type
-					licenseType := detectLicenseType(contentStr)
-					message := "LICENSE file found"
-					if licenseType != "" {
-						message = licenseType + " license found"
-					}
-					// Show location if not in root dir
-					if dir != ctx.RootDir {
-						relPath, _ := filepath.Rel(ctx.RootDir, fullPath)
-						message += " (at " + relPath + ")"
-					}
-					return CheckResult{
-						ID:       c.ID(),
-						Title:    c.Title(),
-						Severity: SeverityInfo,
-						Passed:   true,
-						Message:  message,
-					}, nil
+					return c.validate(ctx, dir, fullPath, contentStr), nil
 				}
 			}
 		}
@@ -73,6 +59,187 @@ func (c LicenseCheck) Run(ctx Context) (CheckResult, error) {
 	}, nil
 }
 
+// validate checks a license file for unfilled template placeholders and
+// compares it with the license declared in package.json/composer.json
+func (c LicenseCheck) validate(ctx Context, dir, fullPath, content string) CheckResult {
+	// Try to detect license type
+	licenseType := detectLicenseType(content)
+	name := filepath.Base(fullPath)
+	message := "LICENSE file found"
+	if licenseType != "" {
+		message = licenseType + " license found"
+	}
+	// Show location if not in root dir
+	if dir != ctx.RootDir {
+		relPath, _ := filepath.Rel(ctx.RootDir, fullPath)
+		name = relPath
+		message += " (at " + relPath + ")"
+	}
+
+	var details, problems []string
+	if licenseType != "" {
+		details = append(details, "Detected: "+licenseType)
+	} else {
+		details = append(details, "Detected: unknown license text")
+	}
+
+	if placeholders := licensePlaceholders(content); len(placeholders) > 0 {
+		problems = append(problems, name+" still has template placeholders: "+strings.Join(placeholders, ", "))
+	} else if licenseNeedsCopyright(licenseType) {
+		if line := copyrightLine(content); line == "" {
+			problems = append(problems, name+" has no copyright line")
+		} else {
+			details = append(details, "Copyright: "+truncate(line, 80))
+		}
+	}
+
+	for _, manifest := range []string{"package.json", "composer.json"} {
+		declared, ok := manifestLicense(filepath.Join(ctx.RootDir, manifest))
+		if !ok && dir != ctx.RootDir {
+			declared, ok = manifestLicense(filepath.Join(dir, manifest))
+		}
+		if !ok {
+			continue
+		}
+		details = append(details, manifest+": "+declared)
+		if licenseType != "" && !licenseMatches(declared, licenseType) {
+			mismatch := fmt.Sprintf("%s declares %s but %s looks like %s", manifest, declared, name, licenseType)
+			problems = append(problems, mismatch)
+			details = append(details, "Mismatch: "+mismatch)
+		}
+	}
+
+	if len(problems) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  problems[0],
+			Suggestions: append(problems[1:],
+				"Fill in the year and copyright holder in the license text",
+				"Keep the license field in package.json/composer.json in sync with the LICENSE file",
+			),
+			Details: details,
+		}
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  message,
+		Details:  details,
+	}
+}
+
+// licensePlaceholderPattern matches the fill-in fields of common license
+// templates, e.g. [year] [fullname] or <copyright holders>
+var licensePlaceholderPattern = regexp.MustCompile(`(?i)[\[<{](year|yyyy|fullname|full name|name of copyright owner|copyright holders?|owner|author|name of author)[\]>}]`)
+
+// licensePlaceholders returns the unfilled template fields in a license.
+// Apache-2.0 includes [yyyy] and [name of copyright owner] in its appendix,
+// so only text before the appendix is checked.
+func licensePlaceholders(content string) []string {
+	if idx := strings.Index(strings.ToLower(content), "appendix: how to apply"); idx >= 0 {
+		content = content[:idx]
+	}
+	// GPL texts mention <year> and <name of author> in their "How to Apply" section
+	if idx := strings.Index(strings.ToLower(content), "how to apply these terms"); idx >= 0 {
+		content = content[:idx]
+	}
+
+	seen := make(map[string]bool)
+	var found []string
+	for _, match := range licensePlaceholderPattern.FindAllString(content, -1) {
+		if !seen[strings.ToLower(match)] {
+			seen[strings.ToLower(match)] = true
+			found = append(found, match)
+		}
+	}
+	return found
+}
+
+// licenseNeedsCopyright reports whether the license template carries its
+// own copyright line, so a missing one means the template wasn't filled in
+func licenseNeedsCopyright(licenseType string) bool {
+	switch licenseType {
+	case "MIT", "ISC", "BSD", "BSD-2-Clause", "BSD-3-Clause":
+		return true
+	}
+	return false
+}
+
+var copyrightLinePattern = regexp.MustCompile(`(?im)^\s*(copyright|\(c\)|©).*\d{4}.*$`)
+
+// copyrightLine returns the first copyright line with a year, if any
+func copyrightLine(content string) string {
+	return strings.TrimSpace(copyrightLinePattern.FindString(content))
+}
+
+// manifestLicense reads the license field from package.json or
+// composer.json. It may be a string, an array (composer), or a legacy
+// {"type": ...} object (npm).
+func manifestLicense(path string) (string, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	var manifest struct {
+		License interface{} `json:"license"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return "", false
+	}
+
+	switch v := manifest.License.(type) {
+	case string:
+		return v, v != ""
+	case []interface{}:
+		var ids []string
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				ids = append(ids, s)
+			}
+		}
+		return strings.Join(ids, " OR "), len(ids) > 0
+	case map[string]interface{}:
+		if s, ok := v["type"].(string); ok && s != "" {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// licenseMatches reports whether a declared SPDX expression is consistent
+// with the license detected from the text. Any alternative of an OR
+// expression may match, and -only/-or-later suffixes are ignored.
+func licenseMatches(declared, detected string) bool {
+	detected = strings.ToLower(detected)
+	expression := strings.ToLower(strings.TrimSpace(declared))
+	if detected == "proprietary" && (expression == "unlicensed" || strings.HasPrefix(expression, "see license")) {
+		return true
+	}
+	for _, id := range strings.FieldsFunc(expression, func(r rune) bool { return r == ' ' || r == '(' || r == ')' }) {
+		if id == "or" || id == "and" {
+			continue
+		}
+		id = strings.TrimSuffix(strings.TrimSuffix(id, "-only"), "-or-later")
+		id = strings.TrimSuffix(id, "+")
+		switch {
+		case id == detected:
+			return true
+		case detected == "creative commons" && strings.HasPrefix(id, "cc"):
+			return true
+		// Detection without a version (GPL, BSD) matches any version
+		case !strings.ContainsAny(detected, "0123456789") && strings.HasPrefix(id, detected):
+			return true
+		}
+	}
+	return false
+}
+
 // getDirectoriesToCheck returns the current directory and parent directories
 // up to the git root (if in a git repo) or up to 3 levels up
 func getDirectoriesToCheck(rootDir string) []string {
@@ -139,7 +306,7 @@ func detectLicenseType(content string) string {
 
 	if strings.Contains(contentLower, "apache license") &&
 		strings.Contains(contentLower, "version 2.0") {
-		return "Apache 2.0"
+		return "Apache-2.0"
 	}
 
 	if strings.Contains(contentLower, "gnu affero general public license") {
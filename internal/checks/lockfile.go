@@ -0,0 +1,230 @@
+package checks
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LockfileCheck verifies each dependency manifest has a committed lockfile
+// for the package manager in use, so deploys install the tested versions
+type LockfileCheck struct{}
+
+func (c LockfileCheck) ID() string {
+	return "lockfile"
+}
+
+func (c LockfileCheck) Title() string {
+	return "Dependency lockfile"
+}
+
+// npmLockfiles maps each JavaScript package manager to its lockfiles
+var npmLockfiles = map[string][]string{
+	"npm":  {"package-lock.json", "npm-shrinkwrap.json"},
+	"yarn": {"yarn.lock"},
+	"pnpm": {"pnpm-lock.yaml"},
+	"bun":  {"bun.lock", "bun.lockb"},
+}
+
+// npmManagers is npmLockfiles' keys in a stable order
+var npmManagers = []string{"npm", "yarn", "pnpm", "bun"}
+
+func (c LockfileCheck) Run(ctx Context) (CheckResult, error) {
+	var problems, details []string
+	manifests := 0
+	ignored := gitignoredNames(ctx.RootDir)
+
+	for _, dir := range scopedPaths(ctx.RootDir, []string{"."}) {
+		dir = filepath.Clean(dir)
+		label := func(name string) string {
+			return filepath.ToSlash(filepath.Join(dir, name))
+		}
+
+		if fileExists(ctx.RootDir, filepath.Join(dir, "package.json")) {
+			manifests++
+			expected, found, problem := checkNPMLockfile(ctx.RootDir, dir)
+			details = append(details, fmt.Sprintf("%s: expected %s, found %s", label("package.json"), expected, orNone(found)))
+			if problem != "" {
+				problems = append(problems, problem)
+			}
+			problems = append(problems, ignoredLockfiles(found, ignored)...)
+		}
+
+		for _, pair := range [][2]string{
+			{"Gemfile", "Gemfile.lock"},
+			{"composer.json", "composer.lock"},
+			{"go.mod", "go.sum"},
+		} {
+			manifest, lockfile := pair[0], pair[1]
+			if !fileExists(ctx.RootDir, filepath.Join(dir, manifest)) {
+				continue
+			}
+			// A module without requirements has nothing to lock
+			if manifest == "go.mod" && len(goDependencies(filepath.Join(ctx.RootDir, dir, manifest))) == 0 {
+				continue
+			}
+			manifests++
+			var found []string
+			if path := findUp(ctx.RootDir, dir, lockfile); path != "" {
+				found = append(found, path)
+			}
+			details = append(details, fmt.Sprintf("%s: expected %s, found %s", label(manifest), lockfile, orNone(found)))
+			if len(found) == 0 {
+				problems = append(problems, fmt.Sprintf("%s has no %s", label(manifest), lockfile))
+			}
+			problems = append(problems, ignoredLockfiles(found, ignored)...)
+		}
+	}
+
+	if manifests == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No dependency manifest found, skipping",
+		}, nil
+	}
+
+	if len(problems) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  problems[0],
+			Suggestions: append(problems[1:],
+				"Run your package manager's install and commit the lockfile it writes",
+				"Keep only the lockfile of the package manager you deploy with",
+			),
+			Details: details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "Lockfiles present for all dependency manifests",
+		Details:  details,
+	}, nil
+}
+
+// checkNPMLockfile works out the package manager for the package.json in
+// dir (from its packageManager field, else the lockfiles present) and
+// returns the expected lockfile, the JS lockfiles found, and any problem.
+// Lockfiles in parent directories count, for workspaces.
+func checkNPMLockfile(rootDir, dir string) (expected string, found []string, problem string) {
+	manifest := filepath.ToSlash(filepath.Join(dir, "package.json"))
+
+	foundBy := make(map[string]string)
+	for _, manager := range npmManagers {
+		for _, name := range npmLockfiles[manager] {
+			if path := findUp(rootDir, dir, name); path != "" {
+				foundBy[manager] = path
+				found = append(found, path)
+				break
+			}
+		}
+	}
+
+	manager := declaredPackageManager(filepath.Join(rootDir, dir, "package.json"))
+	if manager == "" {
+		if len(foundBy) == 1 {
+			for m := range foundBy {
+				manager = m
+			}
+		} else {
+			manager = "npm"
+		}
+	}
+	expected = strings.Join(npmLockfiles[manager], " or ")
+
+	switch {
+	case len(found) == 0:
+		// A package.json with no dependencies has nothing to lock
+		if len(npmDependencies(filepath.Join(rootDir, dir, "package.json"))) == 0 {
+			return expected, found, ""
+		}
+		return expected, found, fmt.Sprintf("%s has no lockfile (expected %s)", manifest, expected)
+	case foundBy[manager] == "":
+		return expected, found, fmt.Sprintf("%s uses %s but the lockfile is %s", manifest, manager, strings.Join(found, ", "))
+	case len(found) > 1:
+		return expected, found, fmt.Sprintf("%s has lockfiles from several package managers: %s", manifest, strings.Join(found, ", "))
+	}
+	return expected, found, ""
+}
+
+// declaredPackageManager reads the packageManager field of a package.json
+// (e.g. "pnpm@9.1.0") and returns the manager name, or ""
+func declaredPackageManager(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	var pkg struct {
+		PackageManager string `json:"packageManager"`
+	}
+	if json.Unmarshal(data, &pkg) != nil {
+		return ""
+	}
+	name, _, _ := strings.Cut(pkg.PackageManager, "@")
+	if _, ok := npmLockfiles[name]; ok {
+		return name
+	}
+	return ""
+}
+
+// findUp looks for name in dir and its parents up to rootDir, returning the
+// path relative to rootDir, or ""
+func findUp(rootDir, dir, name string) string {
+	for {
+		if fileExists(rootDir, filepath.Join(dir, name)) {
+			return filepath.ToSlash(filepath.Join(dir, name))
+		}
+		if dir == "." || dir == "" {
+			return ""
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// gitignoredNames returns the plain file names and paths listed in the
+// project's .gitignore. Globs and negations are ignored.
+func gitignoredNames(rootDir string) map[string]bool {
+	names := make(map[string]bool)
+	data, err := os.ReadFile(filepath.Join(rootDir, ".gitignore"))
+	if err != nil {
+		return names
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") || strings.ContainsAny(line, "*?[") {
+			continue
+		}
+		names[strings.TrimPrefix(line, "/")] = true
+	}
+	return names
+}
+
+// ignoredLockfiles reports the lockfiles that .gitignore keeps out of the repo
+func ignoredLockfiles(lockfiles []string, ignored map[string]bool) []string {
+	var problems []string
+	for _, path := range lockfiles {
+		if ignored[path] || ignored[filepath.Base(path)] {
+			problems = append(problems, path+" is listed in .gitignore, so it isn't committed")
+		}
+	}
+	return problems
+}
+
+func orNone(paths []string) string {
+	if len(paths) == 0 {
+		return "none"
+	}
+	return strings.Join(paths, ", ")
+}
@@ -0,0 +1,197 @@
+package checks
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// MinifiedAssetsCheck fetches the scripts and stylesheets the live homepage
+// loads from its own origin and warns about any that look unminified.
+// Unminified bundles are larger than they need to be and often ship comments
+// and readable source to every visitor.
+type MinifiedAssetsCheck struct{}
+
+func (c MinifiedAssetsCheck) ID() string {
+	return "minified_assets"
+}
+
+func (c MinifiedAssetsCheck) Title() string {
+	return "Minified JS/CSS"
+}
+
+func (c MinifiedAssetsCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c MinifiedAssetsCheck) DependsOn() []string {
+	return siteDependencies
+}
+
+const (
+	// maxMinifyAssets caps how many bundles are downloaded per scan
+	maxMinifyAssets = 6
+	// Files smaller than this aren't worth minifying (or judging)
+	minMinifySize = 2 << 10
+)
+
+func (c MinifiedAssetsCheck) Run(ctx Context) (CheckResult, error) {
+	page, ok := fetchHomepage(ctx)
+	if !ok {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No site URL configured or homepage unavailable, skipping",
+		}, nil
+	}
+
+	assets := sameOriginAssets(page, siteHostname(ctx))
+	if len(assets) > maxMinifyAssets {
+		assets = assets[:maxMinifyAssets]
+	}
+
+	var unminified, details []string
+	checked := 0
+	for _, asset := range assets {
+		resp, err := doGet(ctx.Client, asset)
+		if err != nil {
+			continue
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		resp.Body.Close()
+		if resp.StatusCode != 200 {
+			continue
+		}
+		if len(body) < minMinifySize {
+			details = append(details, fmt.Sprintf("%s: %d bytes, too small to judge", asset, len(body)))
+			continue
+		}
+		checked++
+		minified, stats := looksMinified(body)
+		verdict := "minified"
+		if !minified {
+			verdict = "not minified"
+			unminified = append(unminified, asset)
+		}
+		details = append(details, fmt.Sprintf("%s: %s (%s)", asset, verdict, stats))
+	}
+
+	if checked == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No same-origin scripts or stylesheets to check, skipping",
+			Details:  details,
+		}, nil
+	}
+
+	if len(unminified) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("%d of %d bundle(s) look unminified", len(unminified), checked),
+			Suggestions: []string{
+				"Build assets in production mode (e.g. NODE_ENV=production, vite build, npm run build)",
+				"Unminified bundles are larger and expose comments and readable source",
+			},
+			Details: details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  fmt.Sprintf("%d bundle(s) minified", checked),
+		Details:  details,
+	}, nil
+}
+
+// sameOriginAssets returns the absolute URLs of the scripts and stylesheets a
+// page loads from the site itself, scripts first
+func sameOriginAssets(page pageSource, siteHost string) []string {
+	base, err := url.Parse(page.Name)
+	if err != nil {
+		return nil
+	}
+
+	var refs []string
+	for _, tag := range sriScriptTag.FindAllString(page.Content, -1) {
+		if m := sriSrcAttr.FindStringSubmatch(tag); m != nil {
+			refs = append(refs, m[1])
+		}
+	}
+	for _, tag := range sriLinkTag.FindAllString(page.Content, -1) {
+		if !sriStylesheetRel.MatchString(tag) {
+			continue
+		}
+		if m := sriHrefAttr.FindStringSubmatch(tag); m != nil {
+			refs = append(refs, m[1])
+		}
+	}
+
+	var assets []string
+	for _, ref := range refs {
+		ref = strings.TrimSpace(ref)
+		if ref == "" || strings.HasPrefix(ref, "data:") {
+			continue
+		}
+		u, err := url.Parse(ref)
+		if err != nil {
+			continue
+		}
+		resolved := base.ResolveReference(u)
+		if resolved.Scheme != "http" && resolved.Scheme != "https" {
+			continue
+		}
+		host := strings.ToLower(resolved.Hostname())
+		if host != siteHost && strings.TrimPrefix(host, "www.") != strings.TrimPrefix(siteHost, "www.") {
+			continue
+		}
+		if !contains(assets, resolved.String()) {
+			assets = append(assets, resolved.String())
+		}
+	}
+	return assets
+}
+
+// looksMinified judges whether a JS or CSS file was minified from its
+// layout: minifiers put everything on a few very long lines and strip
+// indentation, while hand-written source has short, indented lines. stats
+// describes the measurements for Details.
+func looksMinified(body []byte) (minified bool, stats string) {
+	lines := bytes.Count(body, []byte("\n")) + 1
+	longest := 0
+	for _, line := range bytes.Split(body, []byte("\n")) {
+		if len(line) > longest {
+			longest = len(line)
+		}
+	}
+	whitespace := 0
+	for _, b := range body {
+		if b == ' ' || b == '\t' || b == '\n' || b == '\r' {
+			whitespace++
+		}
+	}
+	avgLine := len(body) / lines
+	ratio := float64(whitespace) / float64(len(body))
+	stats = fmt.Sprintf("%d lines, avg %d chars/line, longest %d, %.0f%% whitespace", lines, avgLine, longest, ratio*100)
+
+	// A single long line with little whitespace is minified, even if a
+	// license banner or source map comment sits on lines of its own
+	if avgLine >= 200 || (longest >= 1000 && ratio < 0.1) {
+		return true, stats
+	}
+	return !(avgLine < 100 && ratio >= 0.15), stats
+}
@@ -6,11 +6,13 @@ import (
 	_ "image/gif"
 	_ "image/jpeg"
 	_ "image/png"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 
+	"github.com/preflightsh/preflight/internal/config"
 	_ "golang.org/x/image/webp"
 )
 
@@ -24,6 +26,10 @@ func (c OGTwitterCheck) Title() string {
 	return "OG & Twitter cards configured"
 }
 
+func (c OGTwitterCheck) RunsFromPage() bool {
+	return true
+}
+
 // Recommended dimensions for social images
 const (
 	ogRecommendedWidth  = 1200
@@ -37,92 +43,130 @@ const (
 	twitterMinHeight         = 157
 )
 
-func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
-	cfg := ctx.Config.Checks.SEOMeta
+// imageDimensions are the size thresholds for one kind of social image
+type imageDimensions struct {
+	MinWidth, MinHeight                 int
+	RecommendedWidth, RecommendedHeight int
+}
 
-	// Get configured layout or auto-detect
-	var configuredLayout string
-	if cfg != nil {
-		configuredLayout = cfg.MainLayout
+// withOverrides applies the non-zero values from seoMeta.ogImage or
+// seoMeta.twitterImage
+func (d imageDimensions) withOverrides(override *config.ImageDimensionsConfig) imageDimensions {
+	if override == nil {
+		return d
 	}
-	layoutFile := getLayoutFile(ctx.RootDir, ctx.Config.Stack, configuredLayout)
-
-	if layoutFile == "" {
-		return CheckResult{
-			ID:       c.ID(),
-			Title:    c.Title(),
-			Severity: SeverityInfo,
-			Passed:   true,
-			Message:  "No layout file found, skipping",
-		}, nil
+	if override.MinWidth > 0 {
+		d.MinWidth = override.MinWidth
 	}
-
-	layoutPath := filepath.Join(ctx.RootDir, layoutFile)
-	content, err := os.ReadFile(layoutPath)
-	if err != nil {
-		return CheckResult{
-			ID:       c.ID(),
-			Title:    c.Title(),
-			Severity: SeverityWarn,
-			Passed:   false,
-			Message:  "Could not read layout file: " + layoutFile,
-		}, nil
+	if override.MinHeight > 0 {
+		d.MinHeight = override.MinHeight
+	}
+	if override.RecommendedWidth > 0 {
+		d.RecommendedWidth = override.RecommendedWidth
+	}
+	if override.RecommendedHeight > 0 {
+		d.RecommendedHeight = override.RecommendedHeight
 	}
+	return d
+}
 
-	// Strip comments to avoid false positives on commented-out code
-	contentStr := stripComments(string(content))
+// warning returns a too-small or below-recommended message, or ""
+func (d imageDimensions) warning(label string, width, height int) string {
+	if width < d.MinWidth || height < d.MinHeight {
+		return fmt.Sprintf("%s too small (%dx%d, min %dx%d)", label, width, height, d.MinWidth, d.MinHeight)
+	}
+	if width < d.RecommendedWidth || height < d.RecommendedHeight {
+		return fmt.Sprintf("%s below recommended (%dx%d, recommended %dx%d)", label, width, height, d.RecommendedWidth, d.RecommendedHeight)
+	}
+	return ""
+}
 
-	// For Next.js, check if metadata/generateMetadata exists anywhere in app
-	if strings.Contains(layoutFile, "app/") {
-		hasMetadataInApp := false
-		appDir := filepath.Dir(filepath.Join(ctx.RootDir, layoutFile))
-		generateMetadataPattern := regexp.MustCompile(`(?s)export\s+(async\s+)?function\s+generateMetadata`)
-		metadataExportPattern := regexp.MustCompile(`(?s)export\s+(const|let|var)\s+metadata\s*[=:]`)
+func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
+	cfg := ctx.Config.Checks.SEOMeta
 
-		filepath.Walk(appDir, func(path string, info os.FileInfo, err error) error {
-			if err != nil || hasMetadataInApp {
-				return nil
-			}
-			if info.IsDir() {
-				name := info.Name()
-				if name == "node_modules" || name == ".git" {
-					return filepath.SkipDir
-				}
-				return nil
-			}
-			nameLower := strings.ToLower(info.Name())
-			if !strings.HasSuffix(nameLower, ".tsx") && !strings.HasSuffix(nameLower, ".ts") &&
-				!strings.HasSuffix(nameLower, ".jsx") && !strings.HasSuffix(nameLower, ".js") {
-				return nil
-			}
-			fileContent, err := os.ReadFile(path)
-			if err != nil {
-				return nil
-			}
-			if generateMetadataPattern.Match(fileContent) || metadataExportPattern.Match(fileContent) {
-				hasMetadataInApp = true
-			}
-			return nil
-		})
+	// The rendered page includes tags injected at runtime (helmet,
+	// generateMetadata, partials), so prefer it over the layout source
+	var contentStr, source string
+	page, rendered := renderedHTML(ctx)
+	if rendered {
+		contentStr = page.Content
+		source = page.Name + " (rendered HTML)"
+	} else {
+		// Get configured layout or auto-detect
+		var configuredLayout string
+		if cfg != nil {
+			configuredLayout = cfg.MainLayout
+		}
+		layoutFile := getLayoutFile(ctx.RootDir, ctx.Config.Stack, configuredLayout)
 
-		if hasMetadataInApp {
+		if layoutFile == "" {
 			return CheckResult{
 				ID:       c.ID(),
 				Title:    c.Title(),
 				Severity: SeverityInfo,
 				Passed:   true,
-				Message:  "OG and Twitter metadata configured via Next.js Metadata API",
+				Skipped:  true,
+				Message:  "No layout file found, skipping",
 			}, nil
 		}
+
+		layoutPath := filepath.Join(ctx.RootDir, layoutFile)
+		content, err := os.ReadFile(layoutPath)
+		if err != nil {
+			return CheckResult{
+				ID:       c.ID(),
+				Title:    c.Title(),
+				Severity: SeverityWarn,
+				Passed:   false,
+				Message:  "Could not read layout file: " + layoutFile,
+			}, nil
+		}
+
+		// Strip comments to avoid false positives on commented-out code
+		contentStr = stripComments(string(content))
+
+		// For Next.js, check if metadata/generateMetadata exists anywhere in app
+		if strings.Contains(layoutFile, "app/") {
+			hasMetadataInApp := false
+			appDir := filepath.Dir(filepath.Join(ctx.RootDir, layoutFile))
+			generateMetadataPattern := regexp.MustCompile(`(?s)export\s+(async\s+)?function\s+generateMetadata`)
+			metadataExportPattern := regexp.MustCompile(`(?s)export\s+(const|let|var)\s+metadata\s*[=:]`)
+
+			appRel, _ := filepath.Rel(ctx.RootDir, appDir)
+			for _, file := range ctx.files().Files(appRel, ".tsx", ".ts", ".jsx", ".js") {
+				fileContent, err := ctx.files().ReadFile(file.Path)
+				if err != nil {
+					continue
+				}
+				if generateMetadataPattern.Match(fileContent) || metadataExportPattern.Match(fileContent) {
+					hasMetadataInApp = true
+					break
+				}
+			}
+
+			if hasMetadataInApp {
+				return CheckResult{
+					ID:       c.ID(),
+					Title:    c.Title(),
+					Severity: SeverityInfo,
+					Passed:   true,
+					Message:  "OG and Twitter metadata configured via Next.js Metadata API",
+				}, nil
+			}
+		}
+		source = layoutFile
 	}
 
-	// OG and Twitter card elements
-	checks := map[string]*regexp.Regexp{
-		"og:image":      regexp.MustCompile(`(?i)<meta[^>]+property=["']og:image["'][^>]*>`),
-		"og:url":        regexp.MustCompile(`(?i)<meta[^>]+property=["']og:url["'][^>]*>`),
-		"og:type":       regexp.MustCompile(`(?i)<meta[^>]+property=["']og:type["'][^>]*>`),
-		"twitter:card":  regexp.MustCompile(`(?i)<meta[^>]+name=["']twitter:card["'][^>]*>`),
-		"twitter:image": regexp.MustCompile(`(?i)<meta[^>]+name=["']twitter:image["'][^>]*>`),
+	// OG and Twitter card elements, in the order they're reported
+	checks := []struct {
+		name    string
+		pattern *regexp.Regexp
+	}{
+		{"og:image", regexp.MustCompile(`(?i)<meta[^>]+property=["']og:image["'][^>]*>`)},
+		{"og:url", regexp.MustCompile(`(?i)<meta[^>]+property=["']og:url["'][^>]*>`)},
+		{"og:type", regexp.MustCompile(`(?i)<meta[^>]+property=["']og:type["'][^>]*>`)},
+		{"twitter:card", regexp.MustCompile(`(?i)<meta[^>]+name=["']twitter:card["'][^>]*>`)},
+		{"twitter:image", regexp.MustCompile(`(?i)<meta[^>]+name=["']twitter:image["'][^>]*>`)},
 	}
 
 	// Alternate patterns for Next.js/React metadata API
@@ -152,11 +196,12 @@ func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
 	ogImageURL := extractMetaContent(contentStr, `property=["']og:image["']`)
 	twitterImageURL := extractMetaContent(contentStr, `name=["']twitter:image["']`)
 
-	for name, pattern := range checks {
-		matched := pattern.MatchString(contentStr)
+	for _, check := range checks {
+		name := check.name
+		matched := check.pattern.MatchString(contentStr)
 
-		// Try alternate patterns
-		if !matched {
+		// Try alternate patterns (source templates only)
+		if !matched && !rendered {
 			if alts, ok := alternates[name]; ok {
 				for _, alt := range alts {
 					if alt.MatchString(contentStr) {
@@ -168,7 +213,7 @@ func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
 		}
 
 		// Try Next.js Metadata API patterns (multi-line aware)
-		if !matched {
+		if !matched && !rendered {
 			matched = hasNextJSOGTwitterMeta(contentStr, name)
 		}
 
@@ -179,99 +224,98 @@ func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
 		}
 	}
 
-	// Also check for opengraph-image and twitter-image files in app directory
-	ogImageFiles := []string{
-		"app/opengraph-image.png",
-		"app/opengraph-image.jpg",
-		"app/twitter-image.png",
-		"app/twitter-image.jpg",
-		"public/og-image.png",
-		"public/og-image.jpg",
-		"public/og.png",
-		"public/opengraph.png",
-		"public/opengraph-image.png",
-		"public/twitter-image.png",
-	}
-
+	// Image files only count for the layout source; the rendered page
+	// already shows whether a tag was emitted for them
 	var localOGImagePath, localTwitterImagePath string
-	for _, imgPath := range ogImageFiles {
-		fullPath := filepath.Join(ctx.RootDir, imgPath)
-		if _, err := os.Stat(fullPath); err == nil {
-			if strings.Contains(imgPath, "opengraph") || strings.Contains(imgPath, "og") {
-				missing = removeFromSlice(missing, "og:image")
-				if !contains(found, "og:image") {
-					found = append(found, "og:image (file)")
-				}
-				if localOGImagePath == "" {
-					localOGImagePath = fullPath
-				}
-			}
-			if strings.Contains(imgPath, "twitter") {
-				missing = removeFromSlice(missing, "twitter:image")
-				if !contains(found, "twitter:image") {
-					found = append(found, "twitter:image (file)")
-				}
-				if localTwitterImagePath == "" {
-					localTwitterImagePath = fullPath
-				}
-			}
+	if !rendered {
+		// Also check for opengraph-image and twitter-image files in app directory
+		ogImageFiles := []string{
+			"app/opengraph-image.png",
+			"app/opengraph-image.jpg",
+			"app/twitter-image.png",
+			"app/twitter-image.jpg",
+			"public/og-image.png",
+			"public/og-image.jpg",
+			"public/og.png",
+			"public/opengraph.png",
+			"public/opengraph-image.png",
+			"public/twitter-image.png",
 		}
-	}
 
-	// Flexible search: walk app directories for dynamic image generation files
-	flexImageDirs := []string{"app", "src/app"}
-	for _, dir := range flexImageDirs {
-		dirPath := filepath.Join(ctx.RootDir, dir)
-		if _, err := os.Stat(dirPath); err != nil {
-			continue
-		}
-		filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
-			if err != nil {
-				return nil
-			}
-			if info.IsDir() {
-				name := info.Name()
-				if name == "node_modules" || name == ".git" {
-					return filepath.SkipDir
-				}
-				return nil
-			}
-			nameLower := strings.ToLower(info.Name())
-			relPath, _ := filepath.Rel(ctx.RootDir, path)
-
-			// Check for opengraph-image files (static or dynamic)
-			if strings.HasPrefix(nameLower, "opengraph-image.") {
-				missing = removeFromSlice(missing, "og:image")
-				if !contains(found, "og:image") && !contains(found, "og:image (file)") {
-					found = append(found, "og:image ("+relPath+")")
+		for _, imgPath := range ogImageFiles {
+			fullPath := filepath.Join(ctx.RootDir, imgPath)
+			if _, err := os.Stat(fullPath); err == nil {
+				if strings.Contains(imgPath, "opengraph") || strings.Contains(imgPath, "og") {
+					missing = removeFromSlice(missing, "og:image")
+					if !contains(found, "og:image") {
+						found = append(found, "og:image (file)")
+					}
+					if localOGImagePath == "" {
+						localOGImagePath = fullPath
+					}
 				}
-				if localOGImagePath == "" && (strings.HasSuffix(nameLower, ".png") || strings.HasSuffix(nameLower, ".jpg") || strings.HasSuffix(nameLower, ".jpeg")) {
-					localOGImagePath = path
+				if strings.Contains(imgPath, "twitter") {
+					missing = removeFromSlice(missing, "twitter:image")
+					if !contains(found, "twitter:image") {
+						found = append(found, "twitter:image (file)")
+					}
+					if localTwitterImagePath == "" {
+						localTwitterImagePath = fullPath
+					}
 				}
 			}
+		}
 
-			// Check for twitter-image files (static or dynamic)
-			if strings.HasPrefix(nameLower, "twitter-image.") {
-				missing = removeFromSlice(missing, "twitter:image")
-				missing = removeFromSlice(missing, "twitter:card") // twitter-image implies twitter:card
-				if !contains(found, "twitter:image") && !contains(found, "twitter:image (file)") {
-					found = append(found, "twitter:image ("+relPath+")")
-				}
-				if !contains(found, "twitter:card") {
-					found = append(found, "twitter:card")
+		// Flexible search: walk app directories for dynamic image generation files
+		flexImageDirs := []string{"app", "src/app"}
+		for _, dir := range flexImageDirs {
+			for _, file := range ctx.files().Files(dir) {
+				path := file.Path
+				nameLower := strings.ToLower(filepath.Base(path))
+				relPath := filepath.FromSlash(file.RelPath)
+
+				// Check for opengraph-image files (static or dynamic)
+				if strings.HasPrefix(nameLower, "opengraph-image.") {
+					missing = removeFromSlice(missing, "og:image")
+					if !contains(found, "og:image") && !contains(found, "og:image (file)") {
+						found = append(found, "og:image ("+relPath+")")
+					}
+					if localOGImagePath == "" && (strings.HasSuffix(nameLower, ".png") || strings.HasSuffix(nameLower, ".jpg") || strings.HasSuffix(nameLower, ".jpeg")) {
+						localOGImagePath = path
+					}
 				}
-				if localTwitterImagePath == "" && (strings.HasSuffix(nameLower, ".png") || strings.HasSuffix(nameLower, ".jpg") || strings.HasSuffix(nameLower, ".jpeg")) {
-					localTwitterImagePath = path
+
+				// Check for twitter-image files (static or dynamic)
+				if strings.HasPrefix(nameLower, "twitter-image.") {
+					missing = removeFromSlice(missing, "twitter:image")
+					missing = removeFromSlice(missing, "twitter:card") // twitter-image implies twitter:card
+					if !contains(found, "twitter:image") && !contains(found, "twitter:image (file)") {
+						found = append(found, "twitter:image ("+relPath+")")
+					}
+					if !contains(found, "twitter:card") {
+						found = append(found, "twitter:card")
+					}
+					if localTwitterImagePath == "" && (strings.HasSuffix(nameLower, ".png") || strings.HasSuffix(nameLower, ".jpg") || strings.HasSuffix(nameLower, ".jpeg")) {
+						localTwitterImagePath = path
+					}
 				}
+
 			}
+		}
+	}
 
-			return nil
-		})
+	// Check dimensions of images against the configured or default thresholds
+	ogDims := imageDimensions{ogMinWidth, ogMinHeight, ogRecommendedWidth, ogRecommendedHeight}
+	twitterDims := imageDimensions{twitterMinWidth, twitterMinHeight, twitterRecommendedWidth, twitterRecommendedHeight}
+	if cfg != nil {
+		ogDims = ogDims.withOverrides(cfg.OGImage)
+		twitterDims = twitterDims.withOverrides(cfg.TwitterImage)
 	}
 
-	// Check dimensions of images
 	baseURL := ""
-	if ctx.Config.URLs.Staging != "" {
+	if rendered {
+		baseURL = page.Name
+	} else if ctx.Config.URLs.Staging != "" {
 		baseURL = ctx.Config.URLs.Staging
 	} else if ctx.Config.URLs.Production != "" {
 		baseURL = ctx.Config.URLs.Production
@@ -284,12 +328,8 @@ func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
 			width, height, err := fetchImageDimensions(ctx, fullURL)
 			if err == nil {
 				details = append(details, fmt.Sprintf("og:image dimensions: %dx%d", width, height))
-				if width < ogMinWidth || height < ogMinHeight {
-					dimensionWarnings = append(dimensionWarnings,
-						fmt.Sprintf("og:image too small (%dx%d, min %dx%d)", width, height, ogMinWidth, ogMinHeight))
-				} else if width < ogRecommendedWidth || height < ogRecommendedHeight {
-					dimensionWarnings = append(dimensionWarnings,
-						fmt.Sprintf("og:image below recommended (%dx%d, recommended %dx%d)", width, height, ogRecommendedWidth, ogRecommendedHeight))
+				if warning := ogDims.warning("og:image", width, height); warning != "" {
+					dimensionWarnings = append(dimensionWarnings, warning)
 				}
 			} else if ctx.Verbose {
 				details = append(details, fmt.Sprintf("og:image fetch error: %v", err))
@@ -299,12 +339,8 @@ func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
 		width, height, err := getLocalImageDimensions(localOGImagePath)
 		if err == nil {
 			details = append(details, fmt.Sprintf("og:image dimensions: %dx%d", width, height))
-			if width < ogMinWidth || height < ogMinHeight {
-				dimensionWarnings = append(dimensionWarnings,
-					fmt.Sprintf("og:image too small (%dx%d, min %dx%d)", width, height, ogMinWidth, ogMinHeight))
-			} else if width < ogRecommendedWidth || height < ogRecommendedHeight {
-				dimensionWarnings = append(dimensionWarnings,
-					fmt.Sprintf("og:image below recommended (%dx%d, recommended %dx%d)", width, height, ogRecommendedWidth, ogRecommendedHeight))
+			if warning := ogDims.warning("og:image", width, height); warning != "" {
+				dimensionWarnings = append(dimensionWarnings, warning)
 			}
 		}
 	}
@@ -316,12 +352,8 @@ func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
 			width, height, err := fetchImageDimensions(ctx, fullURL)
 			if err == nil {
 				details = append(details, fmt.Sprintf("twitter:image dimensions: %dx%d", width, height))
-				if width < twitterMinWidth || height < twitterMinHeight {
-					dimensionWarnings = append(dimensionWarnings,
-						fmt.Sprintf("twitter:image too small (%dx%d, min %dx%d)", width, height, twitterMinWidth, twitterMinHeight))
-				} else if width < twitterRecommendedWidth || height < twitterRecommendedHeight {
-					dimensionWarnings = append(dimensionWarnings,
-						fmt.Sprintf("twitter:image below recommended (%dx%d, recommended %dx%d)", width, height, twitterRecommendedWidth, twitterRecommendedHeight))
+				if warning := twitterDims.warning("twitter:image", width, height); warning != "" {
+					dimensionWarnings = append(dimensionWarnings, warning)
 				}
 			} else if ctx.Verbose {
 				details = append(details, fmt.Sprintf("twitter:image fetch error: %v", err))
@@ -331,12 +363,8 @@ func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
 		width, height, err := getLocalImageDimensions(localTwitterImagePath)
 		if err == nil {
 			details = append(details, fmt.Sprintf("twitter:image dimensions: %dx%d", width, height))
-			if width < twitterMinWidth || height < twitterMinHeight {
-				dimensionWarnings = append(dimensionWarnings,
-					fmt.Sprintf("twitter:image too small (%dx%d, min %dx%d)", width, height, twitterMinWidth, twitterMinHeight))
-			} else if width < twitterRecommendedWidth || height < twitterRecommendedHeight {
-				dimensionWarnings = append(dimensionWarnings,
-					fmt.Sprintf("twitter:image below recommended (%dx%d, recommended %dx%d)", width, height, twitterRecommendedWidth, twitterRecommendedHeight))
+			if warning := twitterDims.warning("twitter:image", width, height); warning != "" {
+				dimensionWarnings = append(dimensionWarnings, warning)
 			}
 		}
 	}
@@ -349,7 +377,7 @@ func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
 			Severity: SeverityInfo,
 			Passed:   true,
 			Message:  "OG and Twitter card metadata configured",
-			Details:  details,
+			Details:  append([]string{"Source: " + source}, details...),
 		}, nil
 	}
 
@@ -370,7 +398,7 @@ func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
 		suggestions = append(suggestions, "Add twitter:card for Twitter/X previews")
 	}
 	if len(dimensionWarnings) > 0 {
-		suggestions = append(suggestions, fmt.Sprintf("Use %dx%d for OG images, %dx%d for Twitter", ogRecommendedWidth, ogRecommendedHeight, twitterRecommendedWidth, twitterRecommendedHeight))
+		suggestions = append(suggestions, fmt.Sprintf("Use %dx%d for OG images, %dx%d for Twitter", ogDims.RecommendedWidth, ogDims.RecommendedHeight, twitterDims.RecommendedWidth, twitterDims.RecommendedHeight))
 	}
 
 	return CheckResult{
@@ -380,7 +408,7 @@ func (c OGTwitterCheck) Run(ctx Context) (CheckResult, error) {
 		Passed:      false,
 		Message:     strings.Join(messages, "; "),
 		Suggestions: suggestions,
-		Details:     details,
+		Details:     append([]string{"Source: " + source}, details...),
 	}, nil
 }
 
@@ -546,22 +574,57 @@ func resolveImageURL(imageURL, baseURL string) string {
 
 // fetchImageDimensions fetches an image from a URL and returns its dimensions
 func fetchImageDimensions(ctx Context, url string) (width, height int, err error) {
+	info, err := fetchImageInfo(ctx, url, false)
+	return info.Width, info.Height, err
+}
+
+// servedImage describes an image as the server delivers it
+type servedImage struct {
+	Width, Height int
+	Format        string // as reported by image.DecodeConfig
+	Size          int64  // bytes; -1 if unknown
+}
+
+// fetchImageInfo fetches an image and decodes its dimensions. Size comes
+// from Content-Length; when the server doesn't send one and needSize is
+// set, the rest of the body is read to count it.
+func fetchImageInfo(ctx Context, url string, needSize bool) (servedImage, error) {
+	info := servedImage{Size: -1}
 	resp, err := doGet(ctx.Client, url)
 	if err != nil {
-		return 0, 0, err
+		return info, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return 0, 0, fmt.Errorf("HTTP %d", resp.StatusCode)
+		return info, fmt.Errorf("HTTP %d", resp.StatusCode)
 	}
+	info.Size = resp.ContentLength
 
-	img, _, err := image.DecodeConfig(resp.Body)
+	counter := &countingReader{r: resp.Body}
+	img, format, err := image.DecodeConfig(counter)
 	if err != nil {
-		return 0, 0, err
+		return info, err
 	}
+	info.Width, info.Height, info.Format = img.Width, img.Height, format
 
-	return img.Width, img.Height, nil
+	if info.Size < 0 && needSize {
+		io.Copy(io.Discard, io.LimitReader(counter, 50<<20))
+		info.Size = counter.n
+	}
+	return info, nil
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
 }
 
 // getLocalImageDimensions reads a local image file and returns its dimensions
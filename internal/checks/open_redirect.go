@@ -0,0 +1,109 @@
+package checks
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// openRedirectProbeHost stands in for an attacker-controlled domain
+const openRedirectProbeHost = "preflight-redirect-probe.example"
+
+// OpenRedirectCheck probes common redirect parameters on the homepage and
+// flags redirects to an external host taken from the query string
+type OpenRedirectCheck struct{}
+
+func (c OpenRedirectCheck) ID() string {
+	return "open_redirect"
+}
+
+func (c OpenRedirectCheck) Title() string {
+	return "Open redirects"
+}
+
+func (c OpenRedirectCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c OpenRedirectCheck) DependsOn() []string {
+	return siteDependencies
+}
+
+func (c OpenRedirectCheck) Run(ctx Context) (CheckResult, error) {
+	baseURL := ctx.Config.URLs.Production
+	if baseURL == "" {
+		baseURL = ctx.Config.URLs.Staging
+	}
+	if baseURL == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No staging or production URL configured, skipping",
+		}, nil
+	}
+
+	// Inspect redirects rather than following them
+	client := noRedirectClient(ctx.Client)
+
+	target := "https://" + openRedirectProbeHost + "/"
+	params := []string{"redirect", "url", "next", "return", "returnTo", "redirect_uri"}
+
+	var details []string
+	var vulnerable []string
+	reachable := false
+	for _, param := range params {
+		probeURL := strings.TrimSuffix(withBasePath(ctx, baseURL), "/") + "/?" + param + "=" + url.QueryEscape(target)
+		resp, err := doGet(client, probeURL)
+		if err != nil {
+			continue
+		}
+		resp.Body.Close()
+		reachable = true
+
+		if resp.StatusCode < 300 || resp.StatusCode >= 400 {
+			continue
+		}
+		location := resp.Header.Get("Location")
+		// Only flag when the probe host shows up verbatim as the redirect host
+		if loc, err := url.Parse(location); err == nil && strings.EqualFold(loc.Hostname(), openRedirectProbeHost) {
+			vulnerable = append(vulnerable, param)
+			details = append(details, fmt.Sprintf("?%s= → %d Location: %s", param, resp.StatusCode, location))
+		}
+	}
+
+	if !reachable {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "Could not reach site to probe for open redirects",
+		}, nil
+	}
+
+	if len(vulnerable) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityError,
+			Passed:   false,
+			Message:  "Redirects to external hosts via: " + strings.Join(vulnerable, ", "),
+			Suggestions: []string{
+				"Only redirect to relative paths or an allowlist of trusted hosts",
+				"Validate redirect parameters server-side before issuing a Location header",
+			},
+			Details: details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  fmt.Sprintf("No open redirects via %d common parameters", len(params)),
+	}, nil
+}
@@ -0,0 +1,496 @@
+package checks
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OutdatedDepsCheck flags direct dependencies that are several major
+// versions behind the latest release. Unlike VulnerabilityCheck it is about
+// maintenance debt, not known CVEs.
+type OutdatedDepsCheck struct{}
+
+func (c OutdatedDepsCheck) ID() string {
+	return "outdated_deps"
+}
+
+func (c OutdatedDepsCheck) Title() string {
+	return "Outdated dependencies"
+}
+
+const (
+	// outdatedMajorsBehind is how many major versions behind counts as outdated
+	outdatedMajorsBehind = 2
+	// maxRegistryLookups caps uncached registry calls per scan
+	maxRegistryLookups = 30
+	// registryCacheTTL is how long a registry answer is reused
+	registryCacheTTL = 24 * time.Hour
+)
+
+var versionNumberPattern = regexp.MustCompile(`\d+(\.\d+)*`)
+
+// dependency is a direct dependency and the version the project uses
+type dependency struct {
+	Ecosystem string // npm, go, rubygems, packagist
+	Name      string
+	Version   string
+}
+
+// outdatedDependency is a dependency with the latest version found
+type outdatedDependency struct {
+	dependency
+	Latest string
+	Behind int
+}
+
+func (c OutdatedDepsCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c OutdatedDepsCheck) Run(ctx Context) (CheckResult, error) {
+	deps := collectDependencies(ctx.RootDir)
+	if len(deps) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No supported dependency manifest found, skipping",
+		}, nil
+	}
+
+	cache := loadRegistryCache()
+	var outdated []outdatedDependency
+	lookups, failures, checked := 0, 0, 0
+	for _, dep := range deps {
+		current := majorVersion(dep.Version)
+		if current < 0 {
+			continue
+		}
+
+		latest, ok := cache.get(dep)
+		if !ok {
+			// Stop early if the cap is reached or the registries are unreachable
+			if lookups >= maxRegistryLookups || failures >= 3 {
+				continue
+			}
+			lookups++
+			var err error
+			latest, err = latestVersion(ctx.Client, dep, current)
+			if err != nil {
+				failures++
+				continue
+			}
+			cache.set(dep, latest)
+		}
+		checked++
+
+		if behind := majorVersion(latest) - current; behind >= outdatedMajorsBehind {
+			outdated = append(outdated, outdatedDependency{dependency: dep, Latest: latest, Behind: behind})
+		}
+	}
+	cache.save()
+
+	var details []string
+	if checked < len(deps) {
+		details = append(details, fmt.Sprintf("Checked %d of %d direct dependencies (registry lookups are capped at %d per scan)", checked, len(deps), maxRegistryLookups))
+	}
+
+	if checked == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "Could not reach package registries, skipping",
+			Details:  details,
+		}, nil
+	}
+
+	if len(outdated) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  fmt.Sprintf("No dependencies %d+ major versions behind (%d checked)", outdatedMajorsBehind, checked),
+			Details:  details,
+		}, nil
+	}
+
+	// Most outdated first
+	sort.SliceStable(outdated, func(i, j int) bool {
+		return outdated[i].Behind > outdated[j].Behind
+	})
+	for i, dep := range outdated {
+		if i >= 10 {
+			details = append(details, fmt.Sprintf("...and %d more", len(outdated)-10))
+			break
+		}
+		details = append(details, fmt.Sprintf("%s %s → %s (%d majors behind, %s)", dep.Name, dep.Version, dep.Latest, dep.Behind, dep.Ecosystem))
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityWarn,
+		Passed:   false,
+		Message:  fmt.Sprintf("%d package(s) %d+ major versions behind", len(outdated), outdatedMajorsBehind),
+		Suggestions: []string{
+			"Plan upgrades for the most outdated packages; old majors stop getting fixes",
+			"Run with --offline to skip registry lookups",
+		},
+		Details: details,
+	}, nil
+}
+
+// collectDependencies reads direct dependencies from package.json, go.mod,
+// Gemfile.lock, and composer.json/composer.lock
+func collectDependencies(rootDir string) []dependency {
+	var deps []dependency
+	deps = append(deps, npmDependencies(filepath.Join(rootDir, "package.json"))...)
+	deps = append(deps, goDependencies(filepath.Join(rootDir, "go.mod"))...)
+	deps = append(deps, gemDependencies(filepath.Join(rootDir, "Gemfile.lock"))...)
+	deps = append(deps, composerDependencies(rootDir)...)
+	return deps
+}
+
+func npmDependencies(path string) []dependency {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil
+	}
+
+	var deps []dependency
+	for _, group := range []map[string]string{pkg.Dependencies, pkg.DevDependencies} {
+		names := make([]string, 0, len(group))
+		for name := range group {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			version := group[name]
+			// Skip workspace, git, file, and URL dependencies
+			if strings.ContainsAny(version, ":/") {
+				continue
+			}
+			deps = append(deps, dependency{Ecosystem: "npm", Name: name, Version: strings.TrimLeft(version, "^~>=<v ")})
+		}
+	}
+	return deps
+}
+
+func goDependencies(path string) []dependency {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var deps []dependency
+	inBlock := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "require (":
+			inBlock = true
+			continue
+		case inBlock && line == ")":
+			inBlock = false
+			continue
+		case strings.HasPrefix(line, "require "):
+			line = strings.TrimPrefix(line, "require ")
+		case !inBlock:
+			continue
+		}
+		if strings.Contains(line, "// indirect") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) >= 2 {
+			deps = append(deps, dependency{Ecosystem: "go", Name: fields[0], Version: fields[1]})
+		}
+	}
+	return deps
+}
+
+// gemDependencies reads the DEPENDENCIES section of Gemfile.lock for direct
+// gems and looks up their resolved versions in the specs
+func gemDependencies(path string) []dependency {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	resolved := make(map[string]string)
+	var direct []string
+	section := ""
+	for _, line := range strings.Split(string(data), "\n") {
+		if line != "" && !strings.HasPrefix(line, " ") {
+			section = strings.TrimSpace(line)
+			continue
+		}
+		switch {
+		case section == "GEM" && strings.HasPrefix(line, "    ") && !strings.HasPrefix(line, "      "):
+			// "    rails (7.1.3)"
+			fields := strings.Fields(line)
+			if len(fields) == 2 {
+				resolved[fields[0]] = strings.Trim(fields[1], "()")
+			}
+		case section == "DEPENDENCIES" && strings.HasPrefix(line, "  "):
+			fields := strings.Fields(line)
+			if len(fields) > 0 {
+				direct = append(direct, strings.TrimSuffix(fields[0], "!"))
+			}
+		}
+	}
+
+	var deps []dependency
+	for _, name := range direct {
+		if version, ok := resolved[name]; ok {
+			deps = append(deps, dependency{Ecosystem: "rubygems", Name: name, Version: version})
+		}
+	}
+	return deps
+}
+
+// composerDependencies reads direct requirements from composer.json and
+// their installed versions from composer.lock
+func composerDependencies(rootDir string) []dependency {
+	data, err := os.ReadFile(filepath.Join(rootDir, "composer.json"))
+	if err != nil {
+		return nil
+	}
+	var manifest struct {
+		Require    map[string]string `json:"require"`
+		RequireDev map[string]string `json:"require-dev"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil
+	}
+
+	data, err = os.ReadFile(filepath.Join(rootDir, "composer.lock"))
+	if err != nil {
+		return nil
+	}
+	var lock struct {
+		Packages    []struct{ Name, Version string } `json:"packages"`
+		PackagesDev []struct{ Name, Version string } `json:"packages-dev"`
+	}
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil
+	}
+	resolved := make(map[string]string)
+	for _, pkg := range append(lock.Packages, lock.PackagesDev...) {
+		resolved[pkg.Name] = pkg.Version
+	}
+
+	var deps []dependency
+	for _, group := range []map[string]string{manifest.Require, manifest.RequireDev} {
+		names := make([]string, 0, len(group))
+		for name := range group {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			// Platform requirements like php and ext-json aren't packages
+			if !strings.Contains(name, "/") {
+				continue
+			}
+			if version, ok := resolved[name]; ok {
+				deps = append(deps, dependency{Ecosystem: "packagist", Name: name, Version: version})
+			}
+		}
+	}
+	return deps
+}
+
+// majorVersion returns the leading number of a version, or -1
+func majorVersion(version string) int {
+	match := versionNumberPattern.FindString(version)
+	if match == "" {
+		return -1
+	}
+	major, err := strconv.Atoi(strings.SplitN(match, ".", 2)[0])
+	if err != nil {
+		return -1
+	}
+	return major
+}
+
+// latestVersion asks the dependency's registry for its latest release
+func latestVersion(client *http.Client, dep dependency, current int) (string, error) {
+	switch dep.Ecosystem {
+	case "npm":
+		var latest struct {
+			Version string `json:"version"`
+		}
+		err := getRegistryJSON(client, "https://registry.npmjs.org/"+strings.Replace(dep.Name, "/", "%2F", 1)+"/latest", &latest)
+		return latest.Version, err
+	case "rubygems":
+		var latest struct {
+			Version string `json:"version"`
+		}
+		err := getRegistryJSON(client, "https://rubygems.org/api/v1/versions/"+url.PathEscape(dep.Name)+"/latest.json", &latest)
+		return latest.Version, err
+	case "packagist":
+		var meta struct {
+			Packages map[string][]struct {
+				Version string `json:"version"`
+			} `json:"packages"`
+		}
+		if err := getRegistryJSON(client, "https://repo.packagist.org/p2/"+dep.Name+".json", &meta); err != nil {
+			return "", err
+		}
+		// Releases are listed newest first
+		for _, release := range meta.Packages[dep.Name] {
+			if !strings.Contains(strings.ToLower(release.Version), "dev") {
+				return release.Version, nil
+			}
+		}
+		return "", fmt.Errorf("no releases for %s", dep.Name)
+	case "go":
+		return latestGoMajor(client, dep.Name, current)
+	}
+	return "", fmt.Errorf("unknown ecosystem %s", dep.Ecosystem)
+}
+
+var goMajorSuffix = regexp.MustCompile(`/v\d+$`)
+
+// latestGoMajor probes the module proxy for the path outdatedMajorsBehind
+// majors ahead (module/vN), since each Go major version is its own module
+func latestGoMajor(client *http.Client, module string, current int) (string, error) {
+	if current < 1 {
+		current = 1
+	}
+	base := goMajorSuffix.ReplaceAllString(module, "")
+	next := fmt.Sprintf("%s/v%d", base, current+outdatedMajorsBehind)
+
+	var latest struct {
+		Version string `json:"Version"`
+	}
+	err := getRegistryJSON(client, "https://proxy.golang.org/"+escapeModulePath(next)+"/@latest", &latest)
+	if err == errRegistryNotFound {
+		// No newer major exists; report the current one as latest
+		return fmt.Sprintf("v%d", current), nil
+	}
+	return latest.Version, err
+}
+
+// escapeModulePath applies the module proxy's case encoding (A → !a)
+func escapeModulePath(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteByte('!')
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+var errRegistryNotFound = fmt.Errorf("not found in registry")
+
+func getRegistryJSON(client *http.Client, registryURL string, v interface{}) error {
+	resp, err := doGet(client, registryURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return errRegistryNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d", registryURL, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, 4<<20)).Decode(v)
+}
+
+// registryCache stores latest versions in ~/.preflight so repeated scans
+// don't hit the registries
+type registryCache struct {
+	path    string
+	entries map[string]registryCacheEntry
+	dirty   bool
+}
+
+type registryCacheEntry struct {
+	Latest    string    `json:"latest"`
+	FetchedAt time.Time `json:"fetchedAt"`
+}
+
+func loadRegistryCache() *registryCache {
+	cache := &registryCache{entries: make(map[string]registryCacheEntry)}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return cache
+	}
+	cache.path = filepath.Join(home, ".preflight", "registry-cache.json")
+	if data, err := os.ReadFile(cache.path); err == nil {
+		json.Unmarshal(data, &cache.entries)
+	}
+	return cache
+}
+
+func (r *registryCache) key(dep dependency) string {
+	key := dep.Ecosystem + ":" + dep.Name
+	if dep.Ecosystem == "go" {
+		// Go lookups depend on the major being probed
+		key += "@" + strconv.Itoa(majorVersion(dep.Version))
+	}
+	return key
+}
+
+func (r *registryCache) get(dep dependency) (string, bool) {
+	entry, ok := r.entries[r.key(dep)]
+	if !ok || time.Since(entry.FetchedAt) > registryCacheTTL {
+		return "", false
+	}
+	return entry.Latest, true
+}
+
+func (r *registryCache) set(dep dependency, latest string) {
+	r.entries[r.key(dep)] = registryCacheEntry{Latest: latest, FetchedAt: time.Now()}
+	r.dirty = true
+}
+
+func (r *registryCache) save() {
+	if !r.dirty || r.path == "" {
+		return
+	}
+	for key, entry := range r.entries {
+		if time.Since(entry.FetchedAt) > registryCacheTTL {
+			delete(r.entries, key)
+		}
+	}
+	data, err := json.Marshal(r.entries)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return
+	}
+	os.WriteFile(r.path, data, 0644)
+}
@@ -23,6 +23,7 @@ func (c PayPalCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "PayPal not declared, skipping",
 		}, nil
 	}
@@ -89,6 +90,7 @@ func (c BraintreeCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Braintree not declared, skipping",
 		}, nil
 	}
@@ -155,6 +157,7 @@ func (c PaddleCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Paddle not declared, skipping",
 		}, nil
 	}
@@ -221,6 +224,7 @@ func (c LemonSqueezyCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "LemonSqueezy not declared, skipping",
 		}, nil
 	}
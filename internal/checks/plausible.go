@@ -4,7 +4,6 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
-	"strings"
 )
 
 type PlausibleCheck struct{}
@@ -26,6 +25,7 @@ func (c PlausibleCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Plausible not declared, skipping",
 		}, nil
 	}
@@ -47,6 +47,7 @@ func (c PlausibleCheck) Run(ctx Context) (CheckResult, error) {
 		"public/index.html",
 		"src/index.html",
 	)
+	filesToCheck = scopedPaths(ctx.RootDir, filesToCheck)
 
 	found := false
 	var checkedFiles []string
@@ -77,19 +78,27 @@ func (c PlausibleCheck) Run(ctx Context) (CheckResult, error) {
 		searchDirs := []string{"src", "app", "components"}
 		extensions := []string{".tsx", ".jsx", ".js", ".ts"}
 
-		for _, dir := range searchDirs {
-			dirPath := filepath.Join(ctx.RootDir, dir)
+		for _, dirPath := range scopedDirs(ctx.RootDir, searchDirs) {
 			if _, err := os.Stat(dirPath); os.IsNotExist(err) {
 				continue
 			}
 
 			filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
-				if err != nil || info.IsDir() || found {
+				if ctx.Err() != nil {
+					return ctx.Err() // the scan deadline ran out
+				}
+				if err != nil || found {
 					return nil
 				}
 
-				if strings.Contains(path, "node_modules") {
-					return filepath.SkipDir
+				if info.IsDir() {
+					if shouldSkip(ctx.RootDir, path, true) {
+						return filepath.SkipDir
+					}
+					return nil
+				}
+				if shouldSkip(ctx.RootDir, path, false) {
+					return nil
 				}
 
 				ext := filepath.Ext(path)
@@ -0,0 +1,236 @@
+package checks
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// proxyFunc picks the proxy for each request. By default it honors
+// HTTP_PROXY, HTTPS_PROXY, and NO_PROXY like any Go program.
+var proxyFunc = http.ProxyFromEnvironment
+
+// SetProxy routes check requests through a proxy from config. http, https,
+// and socks5 URLs are accepted. Hosts listed in NO_PROXY still connect
+// directly. An empty string keeps the environment settings.
+func SetProxy(raw string) error {
+	if raw == "" {
+		proxyFunc = http.ProxyFromEnvironment
+		return nil
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+	proxyURL, err := url.Parse(raw)
+	if err != nil || proxyURL.Host == "" {
+		return fmt.Errorf("invalid http.proxy %q", raw)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return fmt.Errorf("unsupported http.proxy scheme %q (use http, https, or socks5)", proxyURL.Scheme)
+	}
+
+	noProxy := os.Getenv("NO_PROXY")
+	if noProxy == "" {
+		noProxy = os.Getenv("no_proxy")
+	}
+	proxyFunc = func(req *http.Request) (*url.URL, error) {
+		if bypassProxy(req.URL.Hostname(), noProxy) {
+			return nil, nil
+		}
+		return proxyURL, nil
+	}
+	return nil
+}
+
+// NewHTTPClient returns a client that goes through the configured proxy
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: newTransport(),
+	}
+}
+
+// WithDeadline returns a copy of client whose requests are cancelled when
+// ctx is done, so a check waiting on a hung host gives up at the scan
+// deadline. Clients made by noRedirectClient inherit it.
+func WithDeadline(client *http.Client, ctx context.Context) *http.Client {
+	if client == nil {
+		return nil
+	}
+	bound := *client
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	bound.Transport = deadlineTransport{base: base, ctx: ctx}
+	return &bound
+}
+
+// WithUserAgent returns a copy of client that sends ua, or DefaultUserAgent
+// if ua is empty, with every request that doesn't set its own. The
+// User-Agent rides on the client so the scan and its notification send the
+// same one, e.g. for sites whose WAF blocks unknown clients.
+func WithUserAgent(client *http.Client, ua string) *http.Client {
+	if client == nil {
+		return nil
+	}
+	if ua == "" {
+		ua = DefaultUserAgent
+	}
+	bound := *client
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	bound.Transport = userAgentTransport{base: base, ua: ua}
+	return &bound
+}
+
+// userAgentTransport sets the User-Agent on requests made without one
+type userAgentTransport struct {
+	base http.RoundTripper
+	ua   string
+}
+
+func (t userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("User-Agent") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", t.ua)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// deadlineTransport attaches the scan context to requests made without one
+type deadlineTransport struct {
+	base http.RoundTripper
+	ctx  context.Context
+}
+
+func (t deadlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Done() == nil {
+		req = req.WithContext(t.ctx)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// newTransport returns a transport using the configured proxy, for checks
+// that need their own client (e.g. to stop at redirects)
+func newTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxyFunc
+	return transport
+}
+
+// bypassProxy reports whether host matches a NO_PROXY entry. Entries may be
+// "*", a host or domain (also matching subdomains, with or without a leading
+// dot), an IP, or a CIDR range. Loopback hosts never use the proxy.
+func bypassProxy(host, noProxy string) bool {
+	host = strings.ToLower(host)
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	if ip != nil && ip.IsLoopback() {
+		return true
+	}
+
+	for _, entry := range strings.Split(noProxy, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+		if entry == "*" {
+			return true
+		}
+		if _, cidr, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && cidr.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		if h, _, err := net.SplitHostPort(entry); err == nil {
+			entry = h
+		}
+		entry = strings.TrimPrefix(entry, ".")
+		if host == entry || strings.HasSuffix(host, "."+entry) {
+			return true
+		}
+	}
+	return false
+}
+
+// dialTLS opens a TLS connection to addr (host:port), tunneling through an
+// HTTP proxy with CONNECT when one applies. ctx cancels the dial and handshake. SOCKS proxies aren't supported
+// for raw TLS connections, so those connect directly.
+func dialTLS(ctx context.Context, dialer *net.Dialer, addr string, config *tls.Config) (*tls.Conn, error) {
+	proxyURL, err := proxyFunc(&http.Request{URL: &url.URL{Scheme: "https", Host: addr}})
+	if err != nil || proxyURL == nil || (proxyURL.Scheme != "http" && proxyURL.Scheme != "https") {
+		conn, err := (&tls.Dialer{NetDialer: dialer, Config: config}).DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+		return conn.(*tls.Conn), nil
+	}
+
+	proxyAddr := proxyURL.Host
+	if proxyURL.Port() == "" {
+		port := "80"
+		if proxyURL.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddr = net.JoinHostPort(proxyURL.Hostname(), port)
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL.Scheme == "https" {
+		conn = tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname()})
+	}
+	if dialer.Timeout > 0 {
+		conn.SetDeadline(time.Now().Add(dialer.Timeout))
+	}
+
+	connect := "CONNECT " + addr + " HTTP/1.1\r\nHost: " + addr + "\r\n"
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(proxyURL.User.Username() + ":" + password))
+		connect += "Proxy-Authorization: Basic " + credentials + "\r\n"
+	}
+	if _, err := conn.Write([]byte(connect + "\r\n")); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), &http.Request{Method: "CONNECT"})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy CONNECT to %s failed: %s", addr, resp.Status)
+	}
+
+	if config.ServerName == "" {
+		config = config.Clone()
+		config.ServerName, _, _ = net.SplitHostPort(addr)
+	}
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return tlsConn, nil
+}
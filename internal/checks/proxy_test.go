@@ -0,0 +1,41 @@
+package checks
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWithUserAgent(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+	}))
+	defer server.Close()
+
+	client := WithUserAgent(NewHTTPClient(time.Second), "Custom/2.0")
+	for _, c := range []*http.Client{client, noRedirectClient(client)} {
+		got = ""
+		resp, err := doGet(c, server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if got != "Custom/2.0" {
+			t.Errorf("User-Agent = %q, want Custom/2.0", got)
+		}
+	}
+
+	// A request that sets its own User-Agent keeps it
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	req.Header.Set("User-Agent", "Own/1.0")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got != "Own/1.0" {
+		t.Errorf("User-Agent = %q, want Own/1.0", got)
+	}
+}
@@ -0,0 +1,42 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/time/rate"
+)
+
+// DefaultRateLimit is the requests per second sent to sites when
+// http.rateLimit isn't set: quick enough for a scan, gentle on staging
+const DefaultRateLimit = 10
+
+// limiter spaces out check requests. One limiter is shared by every check in
+// a scan, so crawling and sitemap validation can't burst past it. Wait with
+// the scan context so a throttled check still stops at the deadline.
+var limiter = rate.NewLimiter(DefaultRateLimit, 1)
+
+// SetRateLimit sets the requests per second checks may send, from
+// http.rateLimit. Zero restores the default.
+func SetRateLimit(perSecond float64) error {
+	if perSecond < 0 {
+		return fmt.Errorf("invalid http.rateLimit %v (must be a positive number of requests per second)", perSecond)
+	}
+	if perSecond == 0 {
+		perSecond = DefaultRateLimit
+	}
+	limiter.SetLimit(rate.Limit(perSecond))
+	return nil
+}
+
+// clientContext returns the scan context a client was bound to with
+// WithDeadline, for helpers that are only given the client
+func clientContext(client *http.Client) context.Context {
+	if client != nil {
+		if t, ok := client.Transport.(deadlineTransport); ok && t.ctx != nil {
+			return t.ctx
+		}
+	}
+	return context.Background()
+}
@@ -0,0 +1,34 @@
+package checks
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// A request waiting for a rate-limit slot gives up when the scan is cancelled
+func TestRateLimitWaitStopsAtDeadline(t *testing.T) {
+	if err := SetRateLimit(0.1); err != nil {
+		t.Fatal(err)
+	}
+	defer SetRateLimit(0)
+	limiter.Allow() // use up the burst so the next request has to wait ~10s
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	client := WithDeadline(NewHTTPClient(time.Second), ctx)
+
+	start := time.Now()
+	if _, err := doGet(client, "http://127.0.0.1:1/"); err == nil {
+		t.Fatal("doGet succeeded, want a rate-limit wait error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("doGet waited %v past the deadline", elapsed)
+	}
+}
+
+func TestSetRateLimitRejectsNegative(t *testing.T) {
+	if err := SetRateLimit(-1); err == nil {
+		t.Error("SetRateLimit(-1) succeeded, want an error")
+	}
+}
@@ -0,0 +1,119 @@
+package checks
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ReachabilityCheck probes the configured URLs once before the network
+// checks run. When the production URL (or staging, if it is the only one)
+// can't be reached, it fails with a single error and the checks that depend
+// on it are skipped instead of each reporting the same outage.
+type ReachabilityCheck struct{}
+
+func (c ReachabilityCheck) ID() string {
+	return "reachable"
+}
+
+func (c ReachabilityCheck) Title() string {
+	return "Site reachable"
+}
+
+func (c ReachabilityCheck) RequiresNetwork() bool {
+	return true
+}
+
+// siteDependencies is what checks that request the configured URLs return
+// from DependsOn
+var siteDependencies = []string{"reachable"}
+
+func (c ReachabilityCheck) Run(ctx Context) (CheckResult, error) {
+	type target struct{ name, url string }
+	var targets []target
+	if ctx.Config.URLs.Production != "" {
+		targets = append(targets, target{"Production", ctx.Config.URLs.Production})
+	}
+	if ctx.Config.URLs.Staging != "" {
+		targets = append(targets, target{"Staging", ctx.Config.URLs.Staging})
+	}
+	if len(targets) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No URLs configured, skipping",
+		}, nil
+	}
+
+	var down, details []string
+	primaryDown := false
+	for i, t := range targets {
+		resp, actualURL, err := tryURL(ctx.Client, t.url)
+		if err == nil {
+			resp.Body.Close()
+			details = append(details, fmt.Sprintf("%s: %s (%d)", t.name, actualURL, resp.StatusCode))
+			continue
+		}
+		// A bad certificate still means the server answered; the SSL check
+		// explains what's wrong with it
+		if isCertificateError(err) {
+			details = append(details, fmt.Sprintf("%s: %s (certificate error: %v)", t.name, t.url, err))
+			continue
+		}
+		down = append(down, fmt.Sprintf("%s URL %s", strings.ToLower(t.name), t.url))
+		details = append(details, fmt.Sprintf("%s: %s unreachable: %v", t.name, t.url, err))
+		if i == 0 {
+			primaryDown = true
+		}
+	}
+
+	if len(down) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  "Configured URLs respond",
+			Details:  details,
+		}, nil
+	}
+
+	// Only an unreachable primary URL skips the dependent checks; a staging
+	// outage alongside a working production site is just a warning
+	severity := SeverityWarn
+	suggestions := []string{"Check that the staging environment is running"}
+	if primaryDown {
+		severity = SeverityError
+		suggestions = []string{
+			"Check the URLs in preflight.yml and that the site is up",
+			"Checks against the live site are skipped until it responds",
+			"Run with --offline to check only the project files",
+		}
+	}
+
+	return CheckResult{
+		ID:          c.ID(),
+		Title:       c.Title(),
+		Severity:    severity,
+		Passed:      false,
+		Message:     "Cannot reach " + strings.Join(down, " or "),
+		Suggestions: suggestions,
+		Details:     details,
+	}, nil
+}
+
+// isCertificateError reports whether err is a TLS certificate verification
+// failure rather than a connection failure
+func isCertificateError(err error) bool {
+	var verification *tls.CertificateVerificationError
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostname x509.HostnameError
+	var invalid x509.CertificateInvalidError
+	return errors.As(err, &verification) || errors.As(err, &unknownAuthority) ||
+		errors.As(err, &hostname) || errors.As(err, &invalid)
+}
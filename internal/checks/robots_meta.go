@@ -0,0 +1,151 @@
+package checks
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// RobotsMetaCheck catches a leftover noindex, from a robots meta tag or an
+// X-Robots-Tag header, that would drop the whole site from search results
+type RobotsMetaCheck struct{}
+
+func (c RobotsMetaCheck) ID() string {
+	return "robots_meta"
+}
+
+func (c RobotsMetaCheck) Title() string {
+	return "Robots noindex"
+}
+
+func (c RobotsMetaCheck) RunsFromPage() bool {
+	return true
+}
+
+var (
+	metaTagPattern     = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	robotsNamePattern  = regexp.MustCompile(`(?i)\bname\s*=\s*["']?(robots|googlebot|bingbot)["']?`)
+	metaContentPattern = regexp.MustCompile(`(?i)\bcontent\s*=\s*["']([^"']*)["']`)
+
+	// noindexGuardPattern matches template conditions that usually limit a
+	// noindex to non-production environments
+	noindexGuardPattern = regexp.MustCompile(`(?i)(staging|preview|production|environment|app_env|node_env|vercel_env|rails\.env|isprod|is_prod|noindex\s*[?&|])`)
+)
+
+func (c RobotsMetaCheck) Run(ctx Context) (CheckResult, error) {
+	var problems, details []string
+
+	// Layout files: a noindex inside an environment condition is expected
+	for _, source := range collectLayoutSources(ctx) {
+		lines := strings.Split(source.Content, "\n")
+		for i, line := range lines {
+			directive := robotsNoindex(line)
+			if directive == "" {
+				continue
+			}
+			if isNoindexGuarded(lines, i) {
+				details = append(details, fmt.Sprintf("Meta tag: %s:%d (%s, inside an environment condition)", source.Name, i+1, directive))
+				continue
+			}
+			details = append(details, fmt.Sprintf("Meta tag: %s:%d (%s)", source.Name, i+1, directive))
+			problems = append(problems, fmt.Sprintf("%s has a robots meta tag with %s", source.Name, directive))
+		}
+	}
+
+	// Only production is expected to be indexable; staging should be noindex
+	if prodURL := ctx.Config.URLs.Production; prodURL != "" {
+		if resp, actualURL, err := tryURL(ctx.Client, prodURL); err == nil {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+			resp.Body.Close()
+
+			for _, header := range resp.Header.Values("X-Robots-Tag") {
+				// A header may target one bot: "googlebot: noindex"
+				value := header
+				if idx := strings.Index(value, ":"); idx >= 0 && !strings.Contains(value[:idx], ",") {
+					value = value[idx+1:]
+				}
+				if directive := noindexDirective(value); directive != "" {
+					details = append(details, fmt.Sprintf("Response header: X-Robots-Tag: %s (%s)", header, actualURL))
+					problems = append(problems, fmt.Sprintf("%s sends X-Robots-Tag: %s", actualURL, header))
+				}
+			}
+
+			content := htmlCommentPattern.ReplaceAllString(string(body), "")
+			for _, tag := range metaTagPattern.FindAllString(content, -1) {
+				if directive := robotsNoindex(tag); directive != "" {
+					details = append(details, fmt.Sprintf("Meta tag: %s (%s)", actualURL, directive))
+					problems = append(problems, fmt.Sprintf("%s has a robots meta tag with %s", actualURL, directive))
+				}
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityError,
+			Passed:   false,
+			Message:  problems[0],
+			Suggestions: append(problems[1:],
+				"Remove noindex before launch, or only emit it outside production",
+				"Check your host or CDN for an X-Robots-Tag header set on preview deployments",
+			),
+			Details: details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "No noindex directive found",
+		Details:  details,
+	}, nil
+}
+
+// robotsNoindex returns the noindex directive of a robots meta tag in s, or ""
+func robotsNoindex(s string) string {
+	for _, tag := range metaTagPattern.FindAllString(s, -1) {
+		if !robotsNamePattern.MatchString(tag) {
+			continue
+		}
+		content := metaContentPattern.FindStringSubmatch(tag)
+		if content == nil {
+			continue
+		}
+		if directive := noindexDirective(content[1]); directive != "" {
+			return directive
+		}
+	}
+	return ""
+}
+
+// noindexDirective returns "noindex" or "none" if a robots directive list
+// contains either
+func noindexDirective(value string) string {
+	for _, part := range strings.Split(value, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "noindex" || part == "none" {
+			return part
+		}
+	}
+	return ""
+}
+
+// isNoindexGuarded reports whether the line or the three before it hold an
+// environment condition
+func isNoindexGuarded(lines []string, lineNum int) bool {
+	start := lineNum - 3
+	if start < 0 {
+		start = 0
+	}
+	for i := start; i <= lineNum; i++ {
+		if noindexGuardPattern.MatchString(lines[i]) {
+			return true
+		}
+	}
+	return false
+}
@@ -23,6 +23,7 @@ func (c AlgoliaCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Algolia not declared, skipping",
 		}, nil
 	}
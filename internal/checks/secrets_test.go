@@ -0,0 +1,84 @@
+package checks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/preflightsh/preflight/internal/config"
+)
+
+func TestSecretsRunMultiKeepsDetails(t *testing.T) {
+	root := t.TempDir()
+	const key = "AKIA" + "ABCDEFGHIJKLMNOP"
+	files := map[string]string{
+		"app.js":            "const a = '" + key + "';\nconst b = '" + key + "';\n",
+		"config.yml":        "aws: " + key + "\n",
+		"fixture.js":        "const c = '" + key + "'; // preflight:allow-secret\n",
+		"testdata/keys.yml": "aws: " + key + "\n",
+	}
+	for rel, content := range files {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg := &config.PreflightConfig{}
+	cfg.Checks.Secrets = &config.SecretsConfig{Allow: []string{"testdata/**"}}
+	results, err := SecretScanCheck{}.RunMulti(Context{Context: context.Background(), RootDir: root, Config: cfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want one per file: %+v", len(results), results)
+	}
+
+	details := strings.Join(results[0].Details, "\n")
+	for _, want := range []string{"1 match(es) allowed by preflight:allow-secret", "1 file(s) skipped by checks.secrets.allow"} {
+		if !strings.Contains(details, want) {
+			t.Errorf("Details %q missing %q", details, want)
+		}
+	}
+	if len(results[1].Details) != 0 {
+		t.Errorf("second result repeats Details: %q", results[1].Details)
+	}
+
+	for _, r := range results {
+		if strings.Contains(r.Message, "line") || strings.Contains(r.Message, "AKIA") {
+			t.Errorf("Message %q repeats its locations", r.Message)
+		}
+		if strings.HasSuffix(r.Title, "app.js") && (r.Message != "2 potential secret(s) found" || len(r.Locations) != 2) {
+			t.Errorf("app.js result = %+v, want 2 findings", r)
+		}
+	}
+}
+
+func TestAllowSecretMarkerOnlyInTrailingComment(t *testing.T) {
+	tests := []struct {
+		line    string
+		allowed bool
+	}{
+		{`const key = "AKIA..." // preflight:allow-secret`, true},
+		{`key = "AKIA..."  # preflight:allow-secret`, true},
+		{`AWS_ACCESS_KEY_ID=AKIA...  # preflight:allow-secret`, true},
+		{`$key = 'AKIA...'; /* preflight:allow-secret */`, true},
+		{`<!-- AKIA... preflight:allow-secret -->`, true},
+		{`const key = "AKIA... preflight:allow-secret"`, false},
+		{`const key = "AKIA... // preflight:allow-secret"`, false},
+		{`const key = "AKIA... # preflight:allow-secret"`, false},
+		{`url = "https://example.com/preflight:allow-secret?k=AKIA..."`, false},
+		{`/* preflight:allow-secret */ key = "AKIA..."`, false},
+		{`const key = "AKIA..."`, false},
+	}
+	for _, tt := range tests {
+		if got := allowedByComment(tt.line); got != tt.allowed {
+			t.Errorf("allowedByComment(%q) = %v, want %v", tt.line, got, tt.allowed)
+		}
+	}
+}
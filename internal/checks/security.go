@@ -2,11 +2,30 @@ package checks
 
 import (
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 )
 
 type SecurityHeadersCheck struct{}
 
+// defaultSecurityHeaders are required unless securityHeaders.required
+// replaces them. HSTS is only expected over HTTPS.
+var defaultSecurityHeaders = []string{
+	"Strict-Transport-Security",
+	"X-Content-Type-Options",
+	"Referrer-Policy",
+	"Content-Security-Policy",
+}
+
+// recommendedSecurityHeaders are newer isolation and feature-gating headers.
+// They're reported in Details but never fail the check.
+var recommendedSecurityHeaders = []string{
+	"Permissions-Policy",
+	"Cross-Origin-Opener-Policy",
+	"Cross-Origin-Embedder-Policy",
+}
+
 func (c SecurityHeadersCheck) ID() string {
 	return "securityHeaders"
 }
@@ -15,6 +34,14 @@ func (c SecurityHeadersCheck) Title() string {
 	return "Security headers"
 }
 
+func (c SecurityHeadersCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c SecurityHeadersCheck) DependsOn() []string {
+	return siteDependencies
+}
+
 func (c SecurityHeadersCheck) Run(ctx Context) (CheckResult, error) {
 	prodURL := ctx.Config.URLs.Production
 	stagingURL := ctx.Config.URLs.Staging
@@ -25,43 +52,53 @@ func (c SecurityHeadersCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "No staging or production URL configured, skipping",
 		}, nil
 	}
 
+	required, optional := c.headerSets(ctx)
+	var recommended []string
+	for _, header := range recommendedSecurityHeaders {
+		if !contains(required, header) && !contains(optional, header) {
+			recommended = append(recommended, header)
+		}
+	}
+
 	// Check both environments
 	var results []string
-	var allMissing []string
+	var allMissing, allWeak []string
 	var suggestions []string
+	var details []string
+	var edge *cdnProvider
 	hasFailure := false
 
-	// Check production if configured
-	if prodURL != "" {
-		missing, err := c.checkURL(ctx, prodURL, true)
-		if err != nil {
-			results = append(results, fmt.Sprintf("prod: unreachable"))
-			hasFailure = true
-		} else if len(missing) > 0 {
-			results = append(results, fmt.Sprintf("prod missing: %s", strings.Join(missing, ", ")))
-			allMissing = append(allMissing, missing...)
-			hasFailure = true
-		} else {
-			results = append(results, "prod: ✓")
+	for _, env := range []struct{ name, url string }{{"prod", prodURL}, {"staging", stagingURL}} {
+		if env.url == "" {
+			continue
 		}
-	}
-
-	// Check staging if configured
-	if stagingURL != "" {
-		missing, err := c.checkURL(ctx, stagingURL, false)
+		report, err := c.checkURL(ctx, env.url, required, optional, recommended)
 		if err != nil {
-			results = append(results, fmt.Sprintf("staging: unreachable"))
+			results = append(results, env.name+": unreachable")
 			hasFailure = true
-		} else if len(missing) > 0 {
-			results = append(results, fmt.Sprintf("staging missing: %s", strings.Join(missing, ", ")))
-			allMissing = append(allMissing, missing...)
+			continue
+		}
+		details = append(details, report.details...)
+		if report.cdn != nil && edge == nil {
+			edge = report.cdn
+		}
+		if len(report.missing) > 0 {
+			results = append(results, fmt.Sprintf("%s missing: %s", env.name, strings.Join(report.missing, ", ")))
+			allMissing = append(allMissing, report.missing...)
+		}
+		for _, header := range report.weak {
+			results = append(results, fmt.Sprintf("%s weak: %s: %s", env.name, header, report.values[header]))
+			allWeak = append(allWeak, header)
+		}
+		if len(report.missing) > 0 || len(report.weak) > 0 {
 			hasFailure = true
 		} else {
-			results = append(results, "staging: ✓")
+			results = append(results, env.name+": ✓")
 		}
 	}
 
@@ -72,6 +109,7 @@ func (c SecurityHeadersCheck) Run(ctx Context) (CheckResult, error) {
 			Severity: SeverityInfo,
 			Passed:   true,
 			Message:  strings.Join(results, ", "),
+			Details:  details,
 		}, nil
 	}
 
@@ -95,6 +133,29 @@ func (c SecurityHeadersCheck) Run(ctx Context) (CheckResult, error) {
 		}
 	}
 
+	for _, header := range allWeak {
+		if seen["weak "+header] {
+			continue
+		}
+		seen["weak "+header] = true
+		switch header {
+		case "Referrer-Policy":
+			suggestions = append(suggestions, "Referrer-Policy: strict-origin-when-cross-origin (or no-referrer) keeps full URLs from leaking to other sites")
+		case "X-Content-Type-Options":
+			suggestions = append(suggestions, "X-Content-Type-Options: nosniff is the only valid value")
+		case "Strict-Transport-Security":
+			suggestions = append(suggestions, "HSTS: Strict-Transport-Security: max-age=31536000; includeSubDomains (at least 180 days)")
+		}
+	}
+
+	// Behind a CDN the headers may belong at the edge rather than the origin
+	if edge != nil {
+		suggestions = append(suggestions, fmt.Sprintf("%s fronts this site, so missing headers can also be added at the edge with %s", edge.Name, edge.Settings))
+		if seen["Strict-Transport-Security"] && edge.HSTS != "" {
+			suggestions = append(suggestions, fmt.Sprintf("HSTS is missing at origin but may be enabled at the %s edge: %s", edge.Name, edge.HSTS))
+		}
+	}
+
 	return CheckResult{
 		ID:          c.ID(),
 		Title:       c.Title(),
@@ -102,38 +163,217 @@ func (c SecurityHeadersCheck) Run(ctx Context) (CheckResult, error) {
 		Passed:      false,
 		Message:     strings.Join(results, "\n                    └─ "),
 		Suggestions: suggestions,
+		Details:     details,
 	}, nil
 }
 
-// checkURL checks security headers for a single URL and returns missing headers
-func (c SecurityHeadersCheck) checkURL(ctx Context, url string, isProd bool) ([]string, error) {
+// headerSets returns the required and optional headers from
+// securityHeaders config, falling back to the defaults. A header listed as
+// optional is never required.
+func (c SecurityHeadersCheck) headerSets(ctx Context) (required, optional []string) {
+	cfg := ctx.Config.Checks.SecurityHeaders
+	configured := defaultSecurityHeaders
+	if cfg != nil {
+		if len(cfg.Required) > 0 {
+			configured = cfg.Required
+		}
+		for _, header := range cfg.Optional {
+			optional = append(optional, http.CanonicalHeaderKey(header))
+		}
+	}
+	for _, header := range configured {
+		header = http.CanonicalHeaderKey(header)
+		if !contains(optional, header) && !contains(required, header) {
+			required = append(required, header)
+		}
+	}
+	return required, optional
+}
+
+// headerValueChecks judge the values of security headers whose presence
+// alone says little. Each returns what's wrong with value ("" if sound) and
+// an optional note on what was parsed, both shown in Details.
+var headerValueChecks = map[string]func(value string) (problem, note string){
+	"Referrer-Policy":           referrerPolicyProblem,
+	"X-Content-Type-Options":    contentTypeOptionsProblem,
+	"Strict-Transport-Security": hstsProblem,
+}
+
+// hstsMinMaxAge is the shortest HSTS max-age worth having: 180 days
+const hstsMinMaxAge = 15552000
+
+// contentTypeOptionsProblem accepts only nosniff, the header's one value
+func contentTypeOptionsProblem(value string) (string, string) {
+	if !strings.EqualFold(strings.TrimSpace(value), "nosniff") {
+		return "must be exactly nosniff, so browsers may still sniff content types", ""
+	}
+	return "", ""
+}
+
+// hstsProblem parses max-age, includeSubDomains, and preload. A missing or
+// short max-age is a problem; max-age=0 turns HSTS off. includeSubDomains
+// is only recommended.
+func hstsProblem(value string) (string, string) {
+	maxAge := int64(-1)
+	var subdomains, preload bool
+	for _, directive := range strings.Split(value, ";") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "max-age":
+			if n, err := strconv.ParseInt(strings.Trim(strings.TrimSpace(arg), `"`), 10, 64); err == nil && n >= 0 {
+				maxAge = n
+			}
+		case "includesubdomains":
+			subdomains = true
+		case "preload":
+			preload = true
+		}
+	}
+
+	days := fmt.Sprintf("%d days", maxAge/86400)
+	if maxAge/86400 == 1 {
+		days = "1 day"
+	}
+	parsed := []string{fmt.Sprintf("max-age=%d (%s)", maxAge, days)}
+	if maxAge < 0 {
+		parsed = []string{"no valid max-age"}
+	}
+	if subdomains {
+		parsed = append(parsed, "includeSubDomains")
+	} else {
+		parsed = append(parsed, "no includeSubDomains (recommended)")
+	}
+	if preload {
+		parsed = append(parsed, "preload")
+	}
+	note := strings.Join(parsed, ", ")
+
+	switch {
+	case maxAge < 0:
+		return "no valid max-age, so browsers ignore it", note
+	case maxAge == 0:
+		return "max-age=0 turns HSTS off", note
+	case maxAge < hstsMinMaxAge:
+		return fmt.Sprintf("max-age=%d is under 180 days (%d)", maxAge, hstsMinMaxAge), note
+	}
+	return "", note
+}
+
+// referrerPolicies are the values browsers recognize
+var referrerPolicies = []string{
+	"no-referrer", "no-referrer-when-downgrade", "origin", "origin-when-cross-origin",
+	"same-origin", "strict-origin", "strict-origin-when-cross-origin", "unsafe-url",
+}
+
+// referrerPolicyProblem flags policies that send full URLs (path and query)
+// to other origins
+func referrerPolicyProblem(value string) (string, string) {
+	// Browsers apply the last policy they recognize in a comma-separated list
+	policy := ""
+	for _, token := range strings.Split(value, ",") {
+		token = strings.ToLower(strings.TrimSpace(token))
+		if contains(referrerPolicies, token) {
+			policy = token
+		}
+	}
+	switch policy {
+	case "":
+		return "not a recognized policy, so browsers ignore it", ""
+	case "unsafe-url":
+		return "sends the full URL, including path and query, to every site", ""
+	case "no-referrer-when-downgrade":
+		return "sends the full URL, including path and query, to other HTTPS sites", ""
+	}
+	if policy != strings.ToLower(strings.TrimSpace(value)) {
+		return "", "effective policy " + policy
+	}
+	return "", ""
+}
+
+// headerReport is what checkURL found at one URL
+type headerReport struct {
+	missing []string          // required headers that are absent
+	weak    []string          // required headers whose value has a problem
+	values  map[string]string // raw values of the weak headers
+	details []string
+	cdn     *cdnProvider // CDN that served the response, if detected
+}
+
+// checkURL checks security headers for a single URL: missing required
+// headers, required headers with unsound values, and header values for
+// Details (required and optional ones when verbose; value-checked and
+// recommended ones always).
+func (c SecurityHeadersCheck) checkURL(ctx Context, url string, required, optional, recommended []string) (headerReport, error) {
+	report := headerReport{values: make(map[string]string)}
 	resp, actualURL, err := tryURL(ctx.Client, url)
 	if err != nil {
-		return nil, err
+		return report, err
 	}
 	defer resp.Body.Close()
 
+	// A CDN can add or strip headers, so say which layer answered
+	cdn, evidence := detectCDN(resp.Header)
+	report.cdn = cdn
+	if cdn != nil {
+		report.details = append(report.details, fmt.Sprintf("%s served by %s (%s)", actualURL, cdn.Name, evidence))
+	}
+
 	// Check if we're using HTTPS (HSTS only makes sense over HTTPS)
 	isHTTPS := strings.HasPrefix(actualURL, "https://")
 
-	// Required security headers
-	requiredHeaders := []string{
-		"X-Content-Type-Options",
-		"Referrer-Policy",
-		"Content-Security-Policy",
+	for _, header := range required {
+		if header == "Strict-Transport-Security" && !isHTTPS {
+			continue
+		}
+		value := resp.Header.Get(header)
+		shown := value
+		checkValue, valueChecked := headerValueChecks[header]
+		if value == "" {
+			report.missing = append(report.missing, header)
+			shown = "(missing)"
+			if cdn != nil {
+				shown = fmt.Sprintf("(missing at origin and %s edge)", cdn.Name)
+			}
+		} else {
+			if valueChecked {
+				problem, note := checkValue(value)
+				if problem != "" {
+					report.weak = append(report.weak, header)
+					report.values[header] = value
+					shown += " (" + problem + ")"
+				}
+				if note != "" {
+					shown += " [" + note + "]"
+				}
+			}
+			if cdn != nil && cdn.setsByDefault(header) {
+				shown += fmt.Sprintf(" (likely added by the %s edge)", cdn.Name)
+			}
+		}
+		if ctx.Verbose || (valueChecked && value != "") {
+			report.details = append(report.details, fmt.Sprintf("%s %s: %s", actualURL, header, shown))
+		}
 	}
-
-	// Only check HSTS over HTTPS connections
-	if isHTTPS {
-		requiredHeaders = append([]string{"Strict-Transport-Security"}, requiredHeaders...)
+	for _, header := range optional {
+		value := resp.Header.Get(header)
+		if value == "" {
+			value = "(missing, optional)"
+		} else if checkValue, ok := headerValueChecks[header]; ok {
+			if problem, _ := checkValue(value); problem != "" {
+				value += " (" + problem + ", optional)"
+			}
+		}
+		if ctx.Verbose {
+			report.details = append(report.details, fmt.Sprintf("%s %s: %s", actualURL, header, value))
+		}
 	}
-
-	var missing []string
-	for _, header := range requiredHeaders {
-		if resp.Header.Get(header) == "" {
-			missing = append(missing, header)
+	for _, header := range recommended {
+		value := resp.Header.Get(header)
+		if value == "" {
+			value = "(missing, recommended)"
 		}
+		report.details = append(report.details, fmt.Sprintf("%s %s: %s", actualURL, header, value))
 	}
 
-	return missing, nil
+	return report, nil
 }
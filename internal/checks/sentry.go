@@ -4,7 +4,6 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
-	"strings"
 )
 
 type SentryCheck struct{}
@@ -26,6 +25,7 @@ func (c SentryCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Sentry not declared, skipping",
 		}, nil
 	}
@@ -125,19 +125,27 @@ func (c SentryCheck) Run(ctx Context) (CheckResult, error) {
 
 	found := false
 
-	for _, dir := range searchDirs {
-		dirPath := filepath.Join(ctx.RootDir, dir)
+	for _, dirPath := range scopedDirs(ctx.RootDir, searchDirs) {
 		if _, err := os.Stat(dirPath); os.IsNotExist(err) {
 			continue
 		}
 
 		err := filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
-			if err != nil || info.IsDir() {
+			if ctx.Err() != nil {
+				return ctx.Err() // the scan deadline ran out
+			}
+			if err != nil {
 				return nil
 			}
 
-			// Skip node_modules and vendor
-			if strings.Contains(path, "node_modules") || strings.Contains(path, "vendor") {
+			// Skip node_modules, vendor, and ignored paths
+			if info.IsDir() {
+				if shouldSkip(ctx.RootDir, path, true) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if shouldSkip(ctx.RootDir, path, false) {
 				return nil
 			}
 
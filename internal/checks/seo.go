@@ -17,7 +17,17 @@ func (c SEOMetadataCheck) Title() string {
 	return "SEO metadata"
 }
 
+func (c SEOMetadataCheck) RunsFromPage() bool {
+	return true
+}
+
 func (c SEOMetadataCheck) Run(ctx Context) (CheckResult, error) {
+	// The rendered page includes metadata injected at runtime, so prefer it
+	// over the layout source when a URL is reachable
+	if page, ok := renderedHTML(ctx); ok {
+		return c.result(missingSEOMeta(page.Content), page.Name+" (rendered HTML)"), nil
+	}
+
 	cfg := ctx.Config.Checks.SEOMeta
 
 	// Get configured layout or auto-detect
@@ -33,6 +43,7 @@ func (c SEOMetadataCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "No layout file found, skipping",
 		}, nil
 	}
@@ -63,32 +74,17 @@ func (c SEOMetadataCheck) Run(ctx Context) (CheckResult, error) {
 		generateMetadataPattern := regexp.MustCompile(`(?s)export\s+(async\s+)?function\s+generateMetadata`)
 		metadataExportPattern := regexp.MustCompile(`(?s)export\s+(const|let|var)\s+metadata\s*[=:]`)
 
-		filepath.Walk(appDir, func(path string, info os.FileInfo, err error) error {
-			if err != nil || hasMetadataInApp {
-				return nil
-			}
-			if info.IsDir() {
-				name := info.Name()
-				if name == "node_modules" || name == ".git" {
-					return filepath.SkipDir
-				}
-				return nil
-			}
-			// Only check tsx/ts/jsx/js files
-			nameLower := strings.ToLower(info.Name())
-			if !strings.HasSuffix(nameLower, ".tsx") && !strings.HasSuffix(nameLower, ".ts") &&
-				!strings.HasSuffix(nameLower, ".jsx") && !strings.HasSuffix(nameLower, ".js") {
-				return nil
-			}
-			fileContent, err := os.ReadFile(path)
+		appRel, _ := filepath.Rel(ctx.RootDir, appDir)
+		for _, file := range ctx.files().Files(appRel, ".tsx", ".ts", ".jsx", ".js") {
+			fileContent, err := ctx.files().ReadFile(file.Path)
 			if err != nil {
-				return nil
+				continue
 			}
 			if generateMetadataPattern.Match(fileContent) || metadataExportPattern.Match(fileContent) {
 				hasMetadataInApp = true
+				break
 			}
-			return nil
-		})
+		}
 
 		if hasMetadataInApp {
 			// Metadata is handled somewhere in the app, pass all checks
@@ -102,24 +98,11 @@ func (c SEOMetadataCheck) Run(ctx Context) (CheckResult, error) {
 		}
 	}
 
-	// Required SEO elements
-	checks := map[string]*regexp.Regexp{
-		"title":          regexp.MustCompile(`<title[^>]*>`),
-		"description":    regexp.MustCompile(`<meta[^>]+name=["']description["'][^>]*>`),
-		"og:title":       regexp.MustCompile(`<meta[^>]+property=["']og:title["'][^>]*>`),
-		"og:description": regexp.MustCompile(`<meta[^>]+property=["']og:description["'][^>]*>`),
-	}
-
-	var missing []string
-	for name, pattern := range checks {
-		if !pattern.MatchString(contentStr) {
-			// Check for alternate patterns (some frameworks use different formats)
-			if !checkAlternatePatterns(contentStr, name) {
-				missing = append(missing, name)
-			}
-		}
-	}
+	return c.result(missingSEOMeta(contentStr), layoutFile), nil
+}
 
+// result reports the missing tags found in source (a layout path or URL)
+func (c SEOMetadataCheck) result(missing []string, source string) CheckResult {
 	if len(missing) == 0 {
 		return CheckResult{
 			ID:       c.ID(),
@@ -127,7 +110,8 @@ func (c SEOMetadataCheck) Run(ctx Context) (CheckResult, error) {
 			Severity: SeverityInfo,
 			Passed:   true,
 			Message:  "All required SEO metadata present",
-		}, nil
+			Details:  []string{"Source: " + source},
+		}
 	}
 
 	return CheckResult{
@@ -140,10 +124,44 @@ func (c SEOMetadataCheck) Run(ctx Context) (CheckResult, error) {
 			"Add missing meta tags to your layout",
 			"Consider using a SEO component or helper",
 		},
-	}, nil
+		Details: []string{"Source: " + source},
+	}
+}
+
+// Required SEO elements
+var seoMetaTags = []struct {
+	Name    string
+	Pattern *regexp.Regexp
+}{
+	{"title", regexp.MustCompile(`<title[^>]*>`)},
+	{"description", regexp.MustCompile(`<meta[^>]+name=["']description["'][^>]*>`)},
+	{"og:title", regexp.MustCompile(`<meta[^>]+property=["']og:title["'][^>]*>`)},
+	{"og:description", regexp.MustCompile(`<meta[^>]+property=["']og:description["'][^>]*>`)},
+}
+
+// missingSEOMeta returns the required tags not found in content
+func missingSEOMeta(content string) []string {
+	var missing []string
+	for _, tag := range seoMetaTags {
+		if !tag.Pattern.MatchString(content) {
+			// Check for alternate patterns (some frameworks use different formats)
+			if !checkAlternatePatterns(content, tag.Name) {
+				missing = append(missing, tag.Name)
+			}
+		}
+	}
+	return missing
 }
 
-// getLayoutFile returns the configured layout or auto-detects one based on stack
+// DetectLayout returns the main layout file the SEO checks would use when
+// none is configured, or "" if no known layout exists
+func DetectLayout(rootDir, stack string) string {
+	return getLayoutFile(rootDir, stack, "")
+}
+
+// getLayoutFile returns the configured layout or auto-detects one based on stack.
+// With scoped paths the layout is looked up inside each of them, and the
+// returned path is still relative to rootDir.
 func getLayoutFile(rootDir string, stack string, configuredLayout string) string {
 	// Use configured layout if set
 	if configuredLayout != "" {
@@ -225,11 +243,9 @@ func getLayoutFile(rootDir string, stack string, configuredLayout string) string
 	}
 
 	// Try stack-specific layouts first
-	if layouts, ok := layoutsByStack[stack]; ok {
-		for _, layout := range layouts {
-			if _, err := os.Stat(filepath.Join(rootDir, layout)); err == nil {
-				return layout
-			}
+	for _, scope := range fileIndexFor(rootDir).scopes() {
+		if layout := findLayout(rootDir, scope, layoutsByStack[stack]); layout != "" {
+			return layout
 		}
 	}
 
@@ -240,8 +256,8 @@ func getLayoutFile(rootDir string, stack string, configuredLayout string) string
 		"templates/_layout.twig",
 		"app/views/layouts/application.html.erb",
 	}
-	for _, layout := range commonLayouts {
-		if _, err := os.Stat(filepath.Join(rootDir, layout)); err == nil {
+	for _, scope := range fileIndexFor(rootDir).scopes() {
+		if layout := findLayout(rootDir, scope, commonLayouts); layout != "" {
 			return layout
 		}
 	}
@@ -249,6 +265,17 @@ func getLayoutFile(rootDir string, stack string, configuredLayout string) string
 	return ""
 }
 
+// findLayout returns the first of layouts that exists under scope
+func findLayout(rootDir, scope string, layouts []string) string {
+	for _, layout := range layouts {
+		candidate := filepath.ToSlash(filepath.Join(scope, layout))
+		if _, err := os.Stat(filepath.Join(rootDir, candidate)); err == nil {
+			return candidate
+		}
+	}
+	return ""
+}
+
 func checkAlternatePatterns(content, name string) bool {
 	alternates := map[string][]*regexp.Regexp{
 		"title": {
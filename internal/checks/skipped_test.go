@@ -0,0 +1,35 @@
+package checks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/preflightsh/preflight/internal/config"
+)
+
+// Checks mark their own skips; the wording of Message doesn't matter
+func TestChecksReportSkipped(t *testing.T) {
+	ctx := Context{Context: context.Background(), RootDir: t.TempDir(), Config: &config.PreflightConfig{}}
+
+	for _, check := range []Check{SSLCheck{}, WWWRedirectCheck{}, SentryCheck{}, DockerfileCheck{}, VulnerabilityCheck{}} {
+		result, err := check.Run(ctx)
+		if err != nil {
+			t.Fatalf("%s: %v", check.ID(), err)
+		}
+		if !result.Passed || !result.Skipped {
+			t.Errorf("%s = %+v, want a skipped result", check.ID(), result)
+		}
+	}
+}
+
+func TestPassingResultNotSkipped(t *testing.T) {
+	ctx := Context{Context: context.Background(), RootDir: t.TempDir(), Config: &config.PreflightConfig{}}
+
+	result, err := TodoMarkersCheck{}.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Passed || result.Skipped {
+		t.Errorf("todo_markers = %+v, want a pass that isn't skipped", result)
+	}
+}
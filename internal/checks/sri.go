@@ -0,0 +1,147 @@
+package checks
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// sriExemptHosts serve evergreen loaders whose content changes without a URL
+// change, so an integrity hash would break them (Stripe documents this explicitly)
+var sriExemptHosts = []string{
+	"js.stripe.com",
+	"www.googletagmanager.com",
+	"www.google-analytics.com",
+	"www.google.com",
+	"www.gstatic.com",
+	"fonts.googleapis.com", // CSS varies by user agent
+	"plausible.io",
+	"cdn.usefathom.com",
+	"challenges.cloudflare.com",
+	"static.cloudflareinsights.com",
+}
+
+var (
+	sriScriptTag = regexp.MustCompile(`(?is)<script\b[^>]*>`)
+	sriLinkTag   = regexp.MustCompile(`(?is)<link\b[^>]*>`)
+	// Matches src="...", src='...' and JSX src={"..."} / src={'...'}
+	sriSrcAttr       = regexp.MustCompile(`(?i)\bsrc\s*=\s*\{?\s*["']([^"']+)["']`)
+	sriHrefAttr      = regexp.MustCompile(`(?i)\bhref\s*=\s*\{?\s*["']([^"']+)["']`)
+	sriStylesheetRel = regexp.MustCompile(`(?i)\brel\s*=\s*\{?\s*["']stylesheet["']`)
+	sriIntegrityAttr = regexp.MustCompile(`(?i)\bintegrity\s*=`)
+)
+
+// SRICheck warns about cross-origin scripts and stylesheets loaded without
+// a Subresource Integrity hash
+type SRICheck struct{}
+
+func (c SRICheck) ID() string {
+	return "sri"
+}
+
+func (c SRICheck) Title() string {
+	return "Subresource Integrity"
+}
+
+func (c SRICheck) RunsFromPage() bool {
+	return true
+}
+
+func (c SRICheck) Run(ctx Context) (CheckResult, error) {
+	sources := collectPageSources(ctx)
+	siteHost := siteHostname(ctx)
+
+	if len(sources) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No layout file or site URL found, skipping",
+		}, nil
+	}
+
+	var unprotected []string
+	total := 0
+	for _, source := range sources {
+		content := source.Content
+		for _, tag := range sriScriptTag.FindAllString(content, -1) {
+			if m := sriSrcAttr.FindStringSubmatch(tag); m != nil && isCrossOriginResource(m[1], siteHost) {
+				total++
+				if !sriIntegrityAttr.MatchString(tag) && !contains(unprotected, "script: "+m[1]) {
+					unprotected = append(unprotected, "script: "+m[1])
+				}
+			}
+		}
+		for _, tag := range sriLinkTag.FindAllString(content, -1) {
+			if !sriStylesheetRel.MatchString(tag) {
+				continue
+			}
+			if m := sriHrefAttr.FindStringSubmatch(tag); m != nil && isCrossOriginResource(m[1], siteHost) {
+				total++
+				if !sriIntegrityAttr.MatchString(tag) && !contains(unprotected, "stylesheet: "+m[1]) {
+					unprotected = append(unprotected, "stylesheet: "+m[1])
+				}
+			}
+		}
+	}
+
+	if len(unprotected) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("%d third-party resource(s) without integrity hashes", len(unprotected)),
+			Suggestions: []string{
+				"Add integrity=\"sha384-...\" and crossorigin=\"anonymous\" to CDN <script> and <link> tags",
+				"Generate hashes at https://www.srihash.org or from your CDN's copy button",
+			},
+			Details: unprotected,
+		}, nil
+	}
+
+	if total == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  "No third-party scripts or stylesheets found",
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "All third-party scripts and stylesheets use SRI",
+	}, nil
+}
+
+// isCrossOriginResource reports whether ref is an absolute URL on a host other
+// than the site itself. Templated URLs and SRI-exempt hosts are ignored.
+func isCrossOriginResource(ref, siteHost string) bool {
+	if strings.Contains(ref, "{{") || strings.Contains(ref, "{%") || strings.Contains(ref, "<%") || strings.Contains(ref, "${") {
+		return false
+	}
+	if strings.HasPrefix(ref, "//") {
+		ref = "https:" + ref
+	}
+	if !strings.HasPrefix(ref, "http://") && !strings.HasPrefix(ref, "https://") {
+		return false
+	}
+
+	u, err := url.Parse(ref)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "" || host == siteHost || strings.TrimPrefix(host, "www.") == strings.TrimPrefix(siteHost, "www.") {
+		return false
+	}
+	return !contains(sriExemptHosts, host)
+}
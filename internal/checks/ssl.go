@@ -1,7 +1,10 @@
 package checks
 
 import (
+	"bytes"
 	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
 	"net/url"
@@ -18,6 +21,14 @@ func (c SSLCheck) Title() string {
 	return "SSL certificate"
 }
 
+func (c SSLCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c SSLCheck) DependsOn() []string {
+	return siteDependencies
+}
+
 func (c SSLCheck) Run(ctx Context) (CheckResult, error) {
 	if ctx.Config.URLs.Production == "" {
 		return CheckResult{
@@ -25,6 +36,7 @@ func (c SSLCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "No production URL configured",
 		}, nil
 	}
@@ -54,13 +66,12 @@ func (c SSLCheck) Run(ctx Context) (CheckResult, error) {
 		}, nil
 	}
 
-	host := parsedURL.Host
-	if parsedURL.Port() == "" {
-		host += ":443"
-	}
+	host := tlsAddress(parsedURL)
 
+	// Verification is done below against only the certificates the server
+	// sends, so an incomplete chain can be reported instead of failing the dial
 	dialer := &net.Dialer{Timeout: 10 * time.Second}
-	conn, err := tls.DialWithDialer(dialer, "tcp", host, &tls.Config{})
+	conn, err := dialTLS(ctx, dialer, host, &tls.Config{InsecureSkipVerify: true})
 	if err != nil {
 		return CheckResult{
 			ID:       c.ID(),
@@ -85,6 +96,7 @@ func (c SSLCheck) Run(ctx Context) (CheckResult, error) {
 
 	cert := certs[0]
 	now := time.Now()
+	details, chainErr := verifyChain(certs, parsedURL.Hostname())
 
 	// Check expiration
 	daysUntilExpiry := int(cert.NotAfter.Sub(now).Hours() / 24)
@@ -99,6 +111,7 @@ func (c SSLCheck) Run(ctx Context) (CheckResult, error) {
 			Suggestions: []string{
 				"Renew your SSL certificate immediately",
 			},
+			Details: details,
 		}, nil
 	}
 
@@ -113,6 +126,51 @@ func (c SSLCheck) Run(ctx Context) (CheckResult, error) {
 				"Renew your SSL certificate soon",
 				"Consider enabling auto-renewal",
 			},
+			Details: details,
+		}, nil
+	}
+
+	var hostnameErr x509.HostnameError
+	if errors.As(chainErr, &hostnameErr) {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityError,
+			Passed:   false,
+			Message:  fmt.Sprintf("SSL certificate is not valid for %s", parsedURL.Hostname()),
+			Suggestions: []string{
+				"Issue a certificate that covers this hostname",
+			},
+			Details: details,
+		}, nil
+	}
+
+	var authorityErr x509.UnknownAuthorityError
+	if errors.As(chainErr, &authorityErr) {
+		if len(certs) == 1 && isSelfSigned(cert) {
+			return CheckResult{
+				ID:       c.ID(),
+				Title:    c.Title(),
+				Severity: SeverityWarn,
+				Passed:   false,
+				Message:  "SSL certificate is self-signed",
+				Suggestions: []string{
+					"Use a certificate from a trusted authority such as Let's Encrypt",
+				},
+				Details: details,
+			}, nil
+		}
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "Incomplete certificate chain: intermediate certificates are missing",
+			Suggestions: []string{
+				"Serve the full chain (e.g. fullchain.pem instead of cert.pem)",
+				"Desktop browsers may cache intermediates, but mobile and API clients will fail",
+			},
+			Details: details,
 		}, nil
 	}
 
@@ -126,6 +184,7 @@ func (c SSLCheck) Run(ctx Context) (CheckResult, error) {
 			Suggestions: []string{
 				"Plan to renew your SSL certificate",
 			},
+			Details: details,
 		}, nil
 	}
 
@@ -135,5 +194,54 @@ func (c SSLCheck) Run(ctx Context) (CheckResult, error) {
 		Severity: SeverityInfo,
 		Passed:   true,
 		Message:  fmt.Sprintf("Valid, expires in %d days", daysUntilExpiry),
+		Details:  details,
 	}, nil
 }
+
+// tlsAddress returns the host:port to dial for a TLS connection to the URL,
+// defaulting to port 443
+func tlsAddress(u *url.URL) string {
+	if u.Port() == "" {
+		return net.JoinHostPort(u.Hostname(), "443")
+	}
+	return u.Host
+}
+
+// verifyChain checks that the leaf chains to a trusted root using only the
+// intermediates the server sent, and describes the chain and any gaps
+func verifyChain(certs []*x509.Certificate, hostname string) ([]string, error) {
+	details := []string{fmt.Sprintf("Chain length: %d", len(certs))}
+	for i, cert := range certs {
+		details = append(details, fmt.Sprintf("%d: %s (issued by %s)", i, certName(cert), cert.Issuer.CommonName))
+		if i+1 < len(certs) && cert.CheckSignatureFrom(certs[i+1]) != nil {
+			details = append(details, fmt.Sprintf("Gap: %s is not signed by the next certificate sent", certName(cert)))
+		}
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		DNSName:       hostname,
+		Intermediates: intermediates,
+	})
+
+	var authorityErr x509.UnknownAuthorityError
+	if errors.As(err, &authorityErr) && !isSelfSigned(certs[len(certs)-1]) {
+		details = append(details, "Gap: issuer of "+certName(certs[len(certs)-1])+" ("+certs[len(certs)-1].Issuer.CommonName+") was not sent")
+	}
+	return details, err
+}
+
+// certName returns a readable name for a certificate's subject
+func certName(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" {
+		return cert.Subject.CommonName
+	}
+	return cert.Subject.String()
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil
+}
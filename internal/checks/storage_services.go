@@ -23,6 +23,7 @@ func (c AWSS3Check) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "AWS S3 not declared, skipping",
 		}, nil
 	}
@@ -89,6 +90,7 @@ func (c CloudinaryCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Cloudinary not declared, skipping",
 		}, nil
 	}
@@ -156,6 +158,7 @@ func (c CloudflareCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Cloudflare not declared, skipping",
 		}, nil
 	}
@@ -0,0 +1,145 @@
+package checks
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// StripeKeysCheck is the one check that reports Stripe test keys. A live
+// secret key hardcoded in source is a leaked credential, and a test secret
+// key in production config means production takes test payments: both are
+// errors. Publishable test keys, and test keys in source, are warnings.
+type StripeKeysCheck struct{}
+
+func (c StripeKeysCheck) ID() string {
+	return "stripe_keys"
+}
+
+func (c StripeKeysCheck) Title() string {
+	return "Stripe keys"
+}
+
+var (
+	// stripeLiveSecretPattern matches live secret and restricted keys. Live
+	// publishable keys (pk_live_) are meant to be public.
+	stripeLiveSecretPattern = regexp.MustCompile(`\b(sk|rk)_live_[A-Za-z0-9]{10,}`)
+	stripeTestKeyPattern    = regexp.MustCompile(`\b(sk|pk|rk)_test_[A-Za-z0-9]{10,}`)
+)
+
+func (c StripeKeysCheck) Run(ctx Context) (CheckResult, error) {
+	var live, testSecrets, test []Location
+	scan := func(rel string, content []byte, prodConfig bool) {
+		// Production env files are where live keys belong
+		isEnv := strings.HasPrefix(filepath.Base(rel), ".env")
+		for i, line := range strings.Split(string(content), "\n") {
+			trimmed := strings.TrimSpace(line)
+			if prodConfig && (strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "//")) {
+				continue
+			}
+			if key := stripeLiveSecretPattern.FindString(line); key != "" && !isEnv {
+				live = append(live, Location{File: rel, Line: i + 1, Message: maskKey(key)})
+			} else if key := stripeTestKeyPattern.FindString(line); key != "" {
+				location := Location{File: rel, Line: i + 1, Message: maskKey(key)}
+				switch {
+				case !prodConfig:
+					test = append(test, location)
+				case isStripeSecretKey(key):
+					location.Message += " in production config"
+					testSecrets = append(testSecrets, location)
+				default:
+					location.Message += " in production config"
+					test = append(test, location)
+				}
+			}
+		}
+	}
+
+	index := ctx.files()
+	prodFiles := make(map[string]bool)
+	for _, rel := range scopedPaths(ctx.RootDir, prodConfigFiles) {
+		rel = filepath.ToSlash(rel)
+		prodFiles[rel] = true
+		if content, err := index.ReadFile(filepath.Join(ctx.RootDir, rel)); err == nil {
+			scan(rel, content, true)
+		}
+	}
+
+	for _, file := range index.Files("", hardcodedURLExtensions...) {
+		rel := filepath.ToSlash(file.RelPath)
+		if prodFiles[rel] || isTestFile(rel) || file.Size > 500*1024 {
+			continue
+		}
+		if content, err := index.ReadFile(file.Path); err == nil {
+			scan(rel, content, false)
+		}
+	}
+
+	if len(live) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityError,
+			Passed:   false,
+			Message:  fmt.Sprintf("Live Stripe secret key hardcoded in %s:%d", live[0].File, live[0].Line),
+			Suggestions: []string{
+				"Roll the key in the Stripe dashboard: anyone with the source can use it",
+				"Read the secret key from an environment variable (e.g. STRIPE_SECRET_KEY)",
+			},
+			Locations: append(append(live, testSecrets...), test...),
+		}, nil
+	}
+
+	if len(testSecrets) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityError,
+			Passed:   false,
+			Message:  fmt.Sprintf("Stripe test secret key in production config at %s:%d", testSecrets[0].File, testSecrets[0].Line),
+			Suggestions: []string{
+				"Replace sk_test_/rk_test_ keys with live keys from the Stripe dashboard",
+				"Live payments fail or go unrecorded while test keys are deployed",
+			},
+			Locations: append(testSecrets, test...),
+		}, nil
+	}
+
+	if len(test) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("Found %d Stripe test key(s) where production keys are expected", len(test)),
+			Suggestions: []string{
+				"Replace pk_test_ keys in production config with live publishable keys",
+				"Read Stripe keys from environment variables so production uses live keys",
+			},
+			Locations: test,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "No hardcoded Stripe keys found",
+	}, nil
+}
+
+// isStripeSecretKey reports whether key is a secret or restricted key rather
+// than a publishable one
+func isStripeSecretKey(key string) bool {
+	return strings.HasPrefix(key, "sk_") || strings.HasPrefix(key, "rk_")
+}
+
+// maskKey keeps an API key's prefix (e.g. sk_test_) and hides the rest
+func maskKey(key string) string {
+	if i := strings.LastIndex(key, "_"); i >= 0 {
+		return key[:i+1] + "****"
+	}
+	return "****"
+}
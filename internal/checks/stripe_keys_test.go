@@ -0,0 +1,56 @@
+package checks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/preflightsh/preflight/internal/config"
+)
+
+func TestStripeKeysSeverity(t *testing.T) {
+	cases := []struct {
+		name     string
+		files    map[string]string
+		severity Severity
+		passed   bool
+	}{
+		{"live secret in source", map[string]string{"src/pay.js": `const k = "sk_live_abcdefghijklmnop1234"`}, SeverityError, false},
+		{"live secret in production env", map[string]string{".env.production": "STRIPE_SECRET_KEY=sk_live_abcdefghijklmnop1234"}, SeverityInfo, true},
+		{"test secret in production env", map[string]string{".env.production": "STRIPE_SECRET_KEY=sk_test_abcdefghijklmnop1234"}, SeverityError, false},
+		{"test publishable in production env", map[string]string{".env.production": "STRIPE_KEY=pk_test_abcdefghijklmnop1234"}, SeverityWarn, false},
+		{"test secret in source", map[string]string{"src/pay.js": `const k = "sk_test_abcdefghijklmnop1234"`}, SeverityWarn, false},
+		{"commented out in production env", map[string]string{".env.production": "# STRIPE_SECRET_KEY=sk_test_abcdefghijklmnop1234"}, SeverityInfo, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			root := t.TempDir()
+			for rel, content := range tc.files {
+				path := filepath.Join(root, rel)
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content+"\n"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			ctx := Context{Context: context.Background(), RootDir: root, Config: &config.PreflightConfig{}}
+
+			result, err := StripeKeysCheck{}.Run(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Severity != tc.severity || result.Passed != tc.passed {
+				t.Errorf("got %s passed=%v, want %s passed=%v: %s", result.Severity, result.Passed, tc.severity, tc.passed, result.Message)
+			}
+			for _, loc := range result.Locations {
+				if strings.Contains(loc.Message, "abcdefghijklmnop") {
+					t.Errorf("location message %q shows the key body", loc.Message)
+				}
+			}
+		})
+	}
+}
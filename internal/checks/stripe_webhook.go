@@ -2,6 +2,9 @@ package checks
 
 import (
 	"bufio"
+	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -27,6 +30,7 @@ func (c StripeWebhookCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Stripe not declared, skipping",
 		}, nil
 	}
@@ -86,19 +90,27 @@ func (c StripeWebhookCheck) Run(ctx Context) (CheckResult, error) {
 	initFound := false
 	searchDirs := []string{"config", "config/initializers", "src", "app", "lib"}
 
-	for _, dir := range searchDirs {
-		dirPath := filepath.Join(ctx.RootDir, dir)
+	for _, dirPath := range scopedDirs(ctx.RootDir, searchDirs) {
 		if _, err := os.Stat(dirPath); os.IsNotExist(err) {
 			continue
 		}
 
 		filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
-			if err != nil || info.IsDir() || initFound {
+			if ctx.Err() != nil {
+				return ctx.Err() // the scan deadline ran out
+			}
+			if err != nil || initFound {
 				return nil
 			}
 
-			if strings.Contains(path, "node_modules") || strings.Contains(path, "vendor") {
-				return filepath.SkipDir
+			if info.IsDir() {
+				if shouldSkip(ctx.RootDir, path, true) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if shouldSkip(ctx.RootDir, path, false) {
+				return nil
 			}
 
 			ext := filepath.Ext(path)
@@ -146,6 +158,31 @@ func (c StripeWebhookCheck) Run(ctx Context) (CheckResult, error) {
 		suggestions = append(suggestions, "Ensure Stripe is initialized in your application")
 	}
 
+	// Probe the live endpoint with a bad signature: it should be rejected
+	var details []string
+	if cfg := ctx.Config.Checks.StripeWebhook; cfg != nil && cfg.URL != "" {
+		probe := c.probeWebhook(ctx, cfg.URL)
+		details = probe.details
+		if probe.severity == SeverityError {
+			return CheckResult{
+				ID:       c.ID(),
+				Title:    c.Title(),
+				Severity: SeverityError,
+				Passed:   false,
+				Message:  probe.problem,
+				Suggestions: []string{
+					"Verify the Stripe-Signature header with stripe.webhooks.constructEvent (or your SDK's equivalent) and return 400 when it fails",
+					"Without verification anyone can POST fake events, e.g. a paid invoice",
+				},
+				Details: details,
+			}, nil
+		}
+		if probe.problem != "" {
+			issues = append(issues, probe.problem)
+			suggestions = append(suggestions, "Make the webhook route return 400 when the signature check fails")
+		}
+	}
+
 	// Build result
 	if len(issues) == 0 {
 		message := "Stripe keys configured"
@@ -160,6 +197,7 @@ func (c StripeWebhookCheck) Run(ctx Context) (CheckResult, error) {
 			Severity: SeverityInfo,
 			Passed:   true,
 			Message:  message,
+			Details:  details,
 		}, nil
 	}
 
@@ -170,5 +208,109 @@ func (c StripeWebhookCheck) Run(ctx Context) (CheckResult, error) {
 		Passed:      false,
 		Message:     strings.Join(issues, "; "),
 		Suggestions: suggestions,
+		Details:     details,
 	}, nil
 }
+
+// webhookProbe is the outcome of POSTing a bad signature to the webhook
+type webhookProbe struct {
+	severity Severity // SeverityError when the bad signature was accepted
+	problem  string   // empty when the endpoint rejected the signature
+	details  []string
+}
+
+var (
+	// stripeWebhookRoutePattern finds route strings like "/webhooks/stripe"
+	stripeWebhookRoutePattern = regexp.MustCompile(`(?i)["'\x60](/[a-z0-9_/.-]*(?:stripe[a-z0-9_/.-]*webhook|webhook[a-z0-9_/.-]*stripe)[a-z0-9_/.-]*)["'\x60]`)
+	// nextRouteFilePattern maps an App Router handler file to its URL path
+	nextRouteFilePattern = regexp.MustCompile(`^(?:src/)?app/(.*(?:stripe|webhook).*)/route\.[jt]s$`)
+)
+
+// stripeProbePayload is a clearly fake event, signed with a bogus signature
+const stripeProbePayload = `{"id":"evt_preflight_probe","object":"event","type":"preflight.signature_probe"}`
+
+// probeWebhook POSTs an event with an invalid signature to the webhook URL.
+// A URL without a path is completed with a route found in the source. The
+// handler should answer 400; 2xx means signatures aren't verified.
+func (c StripeWebhookCheck) probeWebhook(ctx Context, configured string) webhookProbe {
+	if ctx.Offline() {
+		return webhookProbe{details: []string{"Offline mode, webhook endpoint not probed"}}
+	}
+
+	target := configured
+	var details []string
+	parsed, err := url.Parse(configured)
+	if err != nil || parsed.Host == "" {
+		return webhookProbe{problem: fmt.Sprintf("stripeWebhook.url %q is not an absolute URL", configured)}
+	}
+	if parsed.Path == "" || parsed.Path == "/" {
+		route, file := detectStripeWebhookRoute(ctx)
+		if route == "" {
+			return webhookProbe{details: []string{"No webhook path in stripeWebhook.url or the source, endpoint not probed"}}
+		}
+		target = strings.TrimSuffix(configured, "/") + route
+		details = append(details, fmt.Sprintf("Webhook path %s detected in %s", route, file))
+	}
+
+	req, err := http.NewRequest("POST", target, strings.NewReader(stripeProbePayload))
+	if err != nil {
+		return webhookProbe{problem: fmt.Sprintf("Could not build webhook probe: %v", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Stripe-Signature", "t=0,v1=preflight-invalid-signature")
+
+	var resp *http.Response
+	if err = limiter.Wait(ctx); err == nil {
+		resp, err = noRedirectClient(ctx.Client).Do(req)
+	}
+	if err != nil {
+		return webhookProbe{
+			problem: "Webhook endpoint unreachable",
+			details: append(details, fmt.Sprintf("POST %s: %v", target, err)),
+		}
+	}
+	resp.Body.Close()
+
+	details = append(details, fmt.Sprintf("POST %s with an invalid signature: %d", target, resp.StatusCode))
+	switch {
+	case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return webhookProbe{details: append(details, "Invalid signature rejected")}
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return webhookProbe{
+			severity: SeverityError,
+			problem:  fmt.Sprintf("Webhook accepted an invalid signature (%d), so signatures aren't verified", resp.StatusCode),
+			details:  details,
+		}
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed:
+		return webhookProbe{problem: fmt.Sprintf("Webhook route not found (%d)", resp.StatusCode), details: details}
+	case resp.StatusCode >= 300 && resp.StatusCode < 400:
+		return webhookProbe{problem: fmt.Sprintf("Webhook URL redirects (%d); Stripe doesn't follow redirects", resp.StatusCode), details: details}
+	default:
+		return webhookProbe{problem: fmt.Sprintf("Webhook returned %d on an invalid signature instead of 400", resp.StatusCode), details: details}
+	}
+}
+
+// detectStripeWebhookRoute looks for the webhook's URL path in the source:
+// a route string mentioning stripe and webhook, or a Next.js App Router
+// handler. It returns the path and the file it came from.
+func detectStripeWebhookRoute(ctx Context) (string, string) {
+	index := ctx.files()
+	for _, file := range index.Files("", ".js", ".ts", ".mjs", ".rb", ".php", ".py", ".go") {
+		if m := nextRouteFilePattern.FindStringSubmatch(file.RelPath); m != nil {
+			return "/" + m[1], file.RelPath
+		}
+	}
+	for _, file := range index.Files("", ".js", ".ts", ".mjs", ".rb", ".php", ".py", ".go") {
+		if isTestFile(file.RelPath) {
+			continue
+		}
+		content, err := index.ReadFile(file.Path)
+		if err != nil {
+			continue
+		}
+		if m := stripeWebhookRoutePattern.FindSubmatch(content); m != nil {
+			return string(m[1]), file.RelPath
+		}
+	}
+	return "", ""
+}
@@ -1,9 +1,14 @@
 package checks
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strings"
 )
 
 type StructuredDataCheck struct{}
@@ -16,9 +21,21 @@ func (c StructuredDataCheck) Title() string {
 	return "Structured data (JSON-LD)"
 }
 
+func (c StructuredDataCheck) RunsFromPage() bool {
+	return true
+}
+
 func (c StructuredDataCheck) Run(ctx Context) (CheckResult, error) {
 	cfg := ctx.Config.Checks.SEOMeta
-	var details []string
+
+	// With a reachable URL, validate the JSON-LD the page actually serves.
+	// A page without any falls through to the source search, since the
+	// markup may live on other pages.
+	if page, ok := fetchHomepage(ctx); ok {
+		if blocks := jsonLDBlockPattern.FindAllStringSubmatch(page.Content, -1); len(blocks) > 0 {
+			return c.validateJSONLD(page.Name, blocks), nil
+		}
+	}
 
 	// Check main layout if configured
 	if cfg != nil && cfg.MainLayout != "" {
@@ -26,16 +43,13 @@ func (c StructuredDataCheck) Run(ctx Context) (CheckResult, error) {
 		content, err := os.ReadFile(layoutPath)
 		if err == nil {
 			if hasStructuredData(string(content), ctx.Config.Stack) {
-				if ctx.Verbose {
-					details = append(details, "Found in: "+cfg.MainLayout)
-				}
 				return CheckResult{
-					ID:       c.ID(),
-					Title:    c.Title(),
-					Severity: SeverityInfo,
-					Passed:   true,
-					Message:  "Schema.org structured data found",
-					Details:  details,
+					ID:        c.ID(),
+					Title:     c.Title(),
+					Severity:  SeverityInfo,
+					Passed:    true,
+					Message:   "Schema.org structured data found",
+					Locations: []Location{{File: cfg.MainLayout}},
 				}, nil
 			}
 		}
@@ -43,16 +57,13 @@ func (c StructuredDataCheck) Run(ctx Context) (CheckResult, error) {
 
 	// Check common partials
 	if matchedPartial := checkStructuredDataPartialsWithDetails(ctx.RootDir, ctx.Config.Stack); matchedPartial != "" {
-		if ctx.Verbose {
-			details = append(details, "Found in: "+matchedPartial)
-		}
 		return CheckResult{
-			ID:       c.ID(),
-			Title:    c.Title(),
-			Severity: SeverityInfo,
-			Passed:   true,
-			Message:  "Schema.org structured data found (in partial)",
-			Details:  details,
+			ID:        c.ID(),
+			Title:     c.Title(),
+			Severity:  SeverityInfo,
+			Passed:    true,
+			Message:   "Schema.org structured data found (in partial)",
+			Locations: []Location{{File: matchedPartial}},
 		}, nil
 	}
 
@@ -64,27 +75,224 @@ func (c StructuredDataCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	if match := searchForPatternsWithDetails(ctx.RootDir, ctx.Config.Stack, patterns); match != nil {
-		if ctx.Verbose {
-			details = append(details, "Found in: "+match.FilePath)
+		return CheckResult{
+			ID:        c.ID(),
+			Title:     c.Title(),
+			Severity:  SeverityInfo,
+			Passed:    true,
+			Message:   "Schema.org structured data found",
+			Locations: []Location{{File: match.FilePath, Line: match.Line}},
+		}, nil
+	}
+
+	return CheckResult{
+		ID:          c.ID(),
+		Title:       c.Title(),
+		Severity:    SeverityWarn,
+		Passed:      false,
+		Message:     "No structured data found",
+		Suggestions: getStructuredDataSuggestions(ctx.Config.Stack),
+	}, nil
+}
+
+var jsonLDBlockPattern = regexp.MustCompile(`(?is)<script[^>]+type\s*=\s*["']?application/ld\+json["']?[^>]*>(.*?)</script>`)
+
+// jsonLDRequired lists the properties Google needs for rich results, by
+// @type. Each entry is a set of alternatives, any one of which satisfies it.
+var jsonLDRequired = map[string][][]string{
+	"Organization":        {{"name"}, {"url"}},
+	"LocalBusiness":       {{"name"}, {"address"}},
+	"WebSite":             {{"name"}, {"url"}},
+	"Person":              {{"name"}},
+	"Product":             {{"name"}, {"offers", "review", "aggregateRating"}},
+	"SoftwareApplication": {{"name"}, {"offers"}},
+	"Article":             {{"headline"}},
+	"NewsArticle":         {{"headline"}},
+	"BlogPosting":         {{"headline"}},
+	"BreadcrumbList":      {{"itemListElement"}},
+	"FAQPage":             {{"mainEntity"}},
+	"HowTo":               {{"name"}, {"step"}},
+	"Event":               {{"name"}, {"startDate"}, {"location"}},
+	"Recipe":              {{"name"}, {"image"}},
+	"Review":              {{"itemReviewed"}, {"author"}},
+	"JobPosting":          {{"title"}, {"description"}, {"datePosted"}, {"hiringOrganization"}},
+}
+
+// validateJSONLD parses each JSON-LD block from pageURL and reports syntax
+// errors, a missing schema.org @context, and missing required properties
+func (c StructuredDataCheck) validateJSONLD(pageURL string, blocks [][]string) CheckResult {
+	var problems, details []string
+	types := make(map[string]bool)
+
+	for i, block := range blocks {
+		label := fmt.Sprintf("Block %d", i+1)
+		raw := strings.TrimSpace(block[1])
+
+		var data interface{}
+		if err := json.Unmarshal([]byte(raw), &data); err != nil {
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				line := strings.Count(raw[:syntaxErr.Offset], "\n") + 1
+				problems = append(problems, fmt.Sprintf("%s: invalid JSON at line %d: %v", label, line, err))
+			} else {
+				problems = append(problems, fmt.Sprintf("%s: invalid JSON: %v", label, err))
+			}
+			continue
+		}
+
+		// A block is one node, an array of nodes, or a node with an @graph
+		var nodes []map[string]interface{}
+		var contexts []interface{}
+		switch v := data.(type) {
+		case map[string]interface{}:
+			if graph, ok := v["@graph"].([]interface{}); ok {
+				for _, item := range graph {
+					if node, ok := item.(map[string]interface{}); ok {
+						nodes = append(nodes, node)
+					}
+				}
+			} else {
+				nodes = append(nodes, v)
+			}
+			contexts = append(contexts, v["@context"])
+		case []interface{}:
+			for _, item := range v {
+				if node, ok := item.(map[string]interface{}); ok {
+					nodes = append(nodes, node)
+					contexts = append(contexts, node["@context"])
+				}
+			}
+		default:
+			problems = append(problems, label+": expected a JSON object or array")
+			continue
+		}
+
+		for _, context := range contexts {
+			if !isSchemaOrgContext(context) {
+				problems = append(problems, label+": @context is missing or not https://schema.org")
+				break
+			}
+		}
+
+		for _, node := range nodes {
+			nodeTypes := jsonLDTypes(node["@type"])
+			if len(nodeTypes) == 0 {
+				problems = append(problems, label+": node without @type")
+				continue
+			}
+			for _, t := range nodeTypes {
+				types[t] = true
+				if missing := missingJSONLDProperties(node, t); len(missing) > 0 {
+					problems = append(problems, fmt.Sprintf("%s: %s missing %s", label, t, strings.Join(missing, ", ")))
+				}
+			}
+		}
+		details = append(details, fmt.Sprintf("%s: %s", label, strings.Join(nodeTypeNames(nodes), ", ")))
+	}
+
+	typeNames := make([]string, 0, len(types))
+	for t := range types {
+		typeNames = append(typeNames, t)
+	}
+	sort.Strings(typeNames)
+
+	if len(problems) > 0 {
+		message := fmt.Sprintf("Invalid JSON-LD on %s: %s", pageURL, problems[0])
+		if len(problems) > 1 {
+			message += fmt.Sprintf(" (and %d more)", len(problems)-1)
 		}
 		return CheckResult{
 			ID:       c.ID(),
 			Title:    c.Title(),
-			Severity: SeverityInfo,
-			Passed:   true,
-			Message:  "Schema.org structured data found",
-			Details:  details,
-		}, nil
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  message,
+			Suggestions: []string{
+				"Fix the JSON-LD and re-test with https://search.google.com/test/rich-results",
+				"Set \"@context\": \"https://schema.org\" and fill in each type's required properties",
+			},
+			Details: append(problems, details...),
+		}
 	}
 
 	return CheckResult{
 		ID:       c.ID(),
 		Title:    c.Title(),
-		Severity: SeverityWarn,
-		Passed:   false,
-		Message:  "No structured data found",
-		Suggestions: getStructuredDataSuggestions(ctx.Config.Stack),
-	}, nil
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  fmt.Sprintf("Valid JSON-LD on %s (%s)", pageURL, strings.Join(typeNames, ", ")),
+		Details:  details,
+	}
+}
+
+// isSchemaOrgContext reports whether an @context (a string, array, or
+// object) refers to schema.org
+func isSchemaOrgContext(context interface{}) bool {
+	switch v := context.(type) {
+	case string:
+		v = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(v)), "/")
+		return v == "https://schema.org" || v == "http://schema.org"
+	case []interface{}:
+		for _, item := range v {
+			if isSchemaOrgContext(item) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		if vocab, ok := v["@vocab"]; ok {
+			return isSchemaOrgContext(vocab)
+		}
+	}
+	return false
+}
+
+// jsonLDTypes returns the @type value(s) of a node
+func jsonLDTypes(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var types []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+// nodeTypeNames describes each node by its @type for Details
+func nodeTypeNames(nodes []map[string]interface{}) []string {
+	var names []string
+	for _, node := range nodes {
+		if types := jsonLDTypes(node["@type"]); len(types) > 0 {
+			names = append(names, strings.Join(types, "/"))
+		} else {
+			names = append(names, "(no @type)")
+		}
+	}
+	return names
+}
+
+// missingJSONLDProperties returns the required properties of type t that
+// node lacks. Unknown types have no requirements.
+func missingJSONLDProperties(node map[string]interface{}, t string) []string {
+	var missing []string
+	for _, alternatives := range jsonLDRequired[t] {
+		found := false
+		for _, prop := range alternatives {
+			if value, ok := node[prop]; ok && value != nil && value != "" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, strings.Join(alternatives, " or "))
+		}
+	}
+	return missing
 }
 
 func hasStructuredData(content, stack string) bool {
@@ -0,0 +1,144 @@
+package checks
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// TodoMarkersCheck counts TODO/FIXME/XXX/HACK comments and fails when there
+// are too many, or when any is tagged as blocking the launch
+type TodoMarkersCheck struct{}
+
+func (c TodoMarkersCheck) ID() string {
+	return "todo_markers"
+}
+
+func (c TodoMarkersCheck) Title() string {
+	return "TODO markers"
+}
+
+const defaultTodoThreshold = 50
+
+var defaultBlockingTags = []string{"launch", "release", "blocker"}
+
+// todoMarkerPattern matches a marker at the start of a comment, with an
+// optional (tag) such as FIXME(launch)
+var todoMarkerPattern = regexp.MustCompile(`(?:(?:^|\s)(?://|#|--)|/\*|^\s*\*|<!--|\{#)\s*@?(TODO|FIXME|XXX|HACK)\b(?:\(([^)]*)\))?`)
+
+// todoMarkerExtensions are the source files scanned
+var todoMarkerExtensions = append([]string{".css", ".scss", ".sh"}, hardcodedURLExtensions...)
+
+func (c TodoMarkersCheck) Run(ctx Context) (CheckResult, error) {
+	threshold := defaultTodoThreshold
+	blockingTags := defaultBlockingTags
+	if cfg := ctx.Config.Checks.TodoMarkers; cfg != nil {
+		if cfg.Threshold > 0 {
+			threshold = cfg.Threshold
+		}
+		if len(cfg.Blocking) > 0 {
+			blockingTags = cfg.Blocking
+		}
+	}
+
+	total := 0
+	perFile := make(map[string]int)
+	var blocking []Location
+
+	index := ctx.files()
+	for _, file := range index.Files("", todoMarkerExtensions...) {
+		if file.Size > 500*1024 {
+			continue
+		}
+		content, err := index.ReadFile(file.Path)
+		if err != nil {
+			continue
+		}
+		for i, line := range strings.Split(string(content), "\n") {
+			m := todoMarkerPattern.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			total++
+			perFile[file.RelPath]++
+			if m[2] != "" && containsFold(blockingTags, strings.TrimSpace(m[2])) {
+				blocking = append(blocking, Location{File: file.RelPath, Line: i + 1, Message: m[1] + "(" + m[2] + ")"})
+			}
+		}
+	}
+
+	details := topTodoFiles(perFile, 5)
+
+	if len(blocking) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityError,
+			Passed:   false,
+			Message:  fmt.Sprintf("%d blocking marker(s) found", len(blocking)),
+			Suggestions: []string{
+				"Resolve markers tagged " + strings.Join(blockingTags, ", ") + " before launch",
+			},
+			Details:   details,
+			Locations: blocking,
+		}, nil
+	}
+
+	if total > threshold {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("%d TODO/FIXME/XXX/HACK markers (threshold %d)", total, threshold),
+			Suggestions: []string{
+				"Resolve or ticket outstanding markers",
+				"Raise checks.todoMarkers.threshold if this count is expected",
+			},
+			Details: details,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  fmt.Sprintf("%d TODO/FIXME/XXX/HACK markers (threshold %d)", total, threshold),
+		Details:  details,
+	}, nil
+}
+
+// topTodoFiles lists the files with the most markers, most first
+func topTodoFiles(perFile map[string]int, limit int) []string {
+	files := make([]string, 0, len(perFile))
+	for file := range perFile {
+		files = append(files, file)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if perFile[files[i]] != perFile[files[j]] {
+			return perFile[files[i]] > perFile[files[j]]
+		}
+		return files[i] < files[j]
+	})
+
+	var lines []string
+	for i, file := range files {
+		if i >= limit {
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%s: %d marker(s)", file, perFile[file]))
+	}
+	return lines
+}
+
+// containsFold is contains with case-insensitive matching
+func containsFold(slice []string, item string) bool {
+	for _, s := range slice {
+		if strings.EqualFold(s, item) {
+			return true
+		}
+	}
+	return false
+}
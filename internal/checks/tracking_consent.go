@@ -0,0 +1,124 @@
+package checks
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// consentManagerPatterns identify a consent platform loaded on the page
+var consentManagerPatterns = map[string]*regexp.Regexp{
+	"Cookiebot":     regexp.MustCompile(`(?i)consent\.cookiebot\.com|Cookiebot`),
+	"OneTrust":      regexp.MustCompile(`(?i)cdn\.cookielaw\.org|otSDKStub|optanon`),
+	"Termly":        regexp.MustCompile(`(?i)app\.termly\.io`),
+	"CookieYes":     regexp.MustCompile(`(?i)cdn-cookieyes\.com`),
+	"Iubenda":       regexp.MustCompile(`(?i)cdn\.iubenda\.com/cs|_iub\.csConfiguration`),
+	"CookieConsent": regexp.MustCompile(`(?i)cookieconsent(\.min)?\.js|CookieConsent\.run|orestbida`),
+	"Osano":         regexp.MustCompile(`(?i)cmp\.osano\.com`),
+}
+
+// trackerPatterns identify tracking scripts that require consent under GDPR
+var trackerPatterns = []struct {
+	name    string
+	pattern *regexp.Regexp
+}{
+	{"Google Analytics", regexp.MustCompile(`googletagmanager\.com/gtag/js|google-analytics\.com/(analytics|ga)\.js|\bgtag\(\s*["']config["']`)},
+	{"Google Tag Manager", regexp.MustCompile(`googletagmanager\.com/gtm\.js`)},
+	{"Facebook Pixel", regexp.MustCompile(`connect\.facebook\.net/[a-zA-Z_]+/fbevents\.js|\bfbq\(\s*["']init["']`)},
+	{"Hotjar", regexp.MustCompile(`static\.hotjar\.com|\bhjid\s*:`)},
+	{"Microsoft Clarity", regexp.MustCompile(`clarity\.ms/tag`)},
+	{"LinkedIn Insight", regexp.MustCompile(`snap\.licdn\.com/li\.lms-analytics`)},
+	{"TikTok Pixel", regexp.MustCompile(`analytics\.tiktok\.com/i18n/pixel`)},
+}
+
+var (
+	scriptBlockPattern = regexp.MustCompile(`(?is)<script\b([^>]*)>(.*?)</script>`)
+	// Attributes consent platforms use to hold a script until consent is given
+	consentGatePattern = regexp.MustCompile(`(?i)type\s*=\s*["']text/plain["']|data-cookieconsent|data-cookiecategory|data-category\s*=|data-cookieyes|class\s*=\s*["'][^"']*optanon-category|class\s*=\s*["'][^"']*_iub_cs_activate|data-consent|data-osano`)
+	// Google Consent Mode defaults tags to denied until the CMP updates them
+	googleConsentModePattern = regexp.MustCompile(`gtag\(\s*["']consent["']\s*,\s*["']default["']`)
+)
+
+// TrackingConsentCheck warns when tracking scripts load unconditionally on a
+// page that also has a consent manager, meaning trackers fire before consent
+type TrackingConsentCheck struct{}
+
+func (c TrackingConsentCheck) ID() string {
+	return "tracking_consent"
+}
+
+func (c TrackingConsentCheck) Title() string {
+	return "Tracking gated by consent"
+}
+
+func (c TrackingConsentCheck) Run(ctx Context) (CheckResult, error) {
+	sources := collectPageSources(ctx)
+
+	var consentManagers []string
+	for name, pattern := range consentManagerPatterns {
+		for _, source := range sources {
+			if pattern.MatchString(source.Content) {
+				consentManagers = append(consentManagers, name)
+				break
+			}
+		}
+	}
+
+	if len(consentManagers) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No consent manager found in layout, skipping",
+		}, nil
+	}
+
+	var ungated []string
+	for _, source := range sources {
+		consentMode := googleConsentModePattern.MatchString(source.Content)
+		for _, block := range scriptBlockPattern.FindAllStringSubmatch(source.Content, -1) {
+			attrs, body := block[1], block[2]
+			if consentGatePattern.MatchString(attrs) {
+				continue
+			}
+			for _, tracker := range trackerPatterns {
+				if !tracker.pattern.MatchString(attrs) && !tracker.pattern.MatchString(body) {
+					continue
+				}
+				// Google tags honor Consent Mode defaults set earlier on the page
+				if consentMode && strings.HasPrefix(tracker.name, "Google") {
+					continue
+				}
+				entry := fmt.Sprintf("%s: %s", source.Name, tracker.name)
+				if !contains(ungated, entry) {
+					ungated = append(ungated, entry)
+				}
+			}
+		}
+	}
+
+	if len(ungated) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("%d tracker(s) load before consent", len(ungated)),
+			Suggestions: []string{
+				"Mark tracking scripts with your consent platform's blocking attributes (e.g. type=\"text/plain\" data-cookieconsent=\"statistics\")",
+				"Or enable your CMP's automatic script blocking / Google Consent Mode",
+			},
+			Details: ungated,
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "Tracking scripts are gated by the consent manager",
+	}, nil
+}
@@ -0,0 +1,232 @@
+package checks
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// TrailingSlashCheck verifies a page is served at only one of /path and
+// /path/, with the other redirecting to it
+type TrailingSlashCheck struct{}
+
+func (c TrailingSlashCheck) ID() string {
+	return "trailing_slash"
+}
+
+func (c TrailingSlashCheck) Title() string {
+	return "Trailing slash"
+}
+
+var (
+	internalLinkPattern = regexp.MustCompile(`(?i)<a\s[^>]*href=["']([^"'#?]+)["']`)
+	canonicalPattern    = regexp.MustCompile(`(?i)<link\s[^>]*rel=["']canonical["'][^>]*href=["']([^"']+)["']|<link\s[^>]*href=["']([^"']+)["'][^>]*rel=["']canonical["']`)
+)
+
+func (c TrailingSlashCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c TrailingSlashCheck) DependsOn() []string {
+	return siteDependencies
+}
+
+func (c TrailingSlashCheck) Run(ctx Context) (CheckResult, error) {
+	if ctx.Config.URLs.Production == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No production URL configured",
+		}, nil
+	}
+
+	resp, homeURL, err := tryURL(ctx.Client, ctx.Config.URLs.Production)
+	if err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "Homepage unreachable, skipping",
+		}, nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	resp.Body.Close()
+
+	base, err := url.Parse(homeURL)
+	if err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "Invalid production URL",
+		}, nil
+	}
+
+	path := representativePath(base, string(body))
+	if path == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "No internal page links found on the homepage, skipping",
+		}, nil
+	}
+
+	bare := *base
+	bare.Path = strings.TrimSuffix(path, "/")
+	slashed := bare
+	slashed.Path = bare.Path + "/"
+
+	bareHop, bareErr := probeNoFollow(ctx.Client, bare.String())
+	slashedHop, slashedErr := probeNoFollow(ctx.Client, slashed.String())
+	details := []string{
+		describeHop(bare.Path, bareHop, bareErr),
+		describeHop(slashed.Path, slashedHop, slashedErr),
+	}
+	if bareErr != nil || slashedErr != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Skipped:  true,
+			Message:  "Could not probe " + bare.Path + ", skipping",
+			Details:  details,
+		}, nil
+	}
+
+	// One form redirects to the other
+	for _, hop := range []redirectHop{bareHop, slashedHop} {
+		if !hop.isRedirect() {
+			continue
+		}
+		if !hop.isPermanent() {
+			return CheckResult{
+				ID:       c.ID(),
+				Title:    c.Title(),
+				Severity: SeverityWarn,
+				Passed:   false,
+				Message:  fmt.Sprintf("Trailing slash redirect on %s uses a temporary %d", bare.Path, hop.Status),
+				Suggestions: []string{
+					"Use a 301 (or 308) permanent redirect to the canonical form",
+				},
+				Details: details,
+			}, nil
+		}
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  fmt.Sprintf("%s redirects to its canonical form", bare.Path),
+			Details:  details,
+		}, nil
+	}
+
+	if bareHop.Status != http.StatusOK || slashedHop.Status != http.StatusOK {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  fmt.Sprintf("Only one form of %s is served", bare.Path),
+			Details:  details,
+		}, nil
+	}
+
+	// Both return 200; a shared canonical URL keeps search engines from
+	// treating them as duplicates
+	bareCanonical := findCanonical(bareHop.Body)
+	if bareCanonical != "" && bareCanonical == findCanonical(slashedHop.Body) {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityInfo,
+			Passed:   true,
+			Message:  fmt.Sprintf("Both forms of %s are served but share a canonical URL", bare.Path),
+			Details:  append(details, "Canonical: "+bareCanonical),
+		}, nil
+	}
+
+	message := fmt.Sprintf("%s and %s both return 200", bare.Path, slashed.Path)
+	if bytes.Equal(bareHop.Body, slashedHop.Body) {
+		message = fmt.Sprintf("%s and %s both return 200 with identical content", bare.Path, slashed.Path)
+	}
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityWarn,
+		Passed:   false,
+		Message:  message,
+		Suggestions: []string{
+			"Pick a trailing slash policy and 301-redirect the other form to it",
+			"Or set the same rel=canonical URL on both",
+		},
+		Details: details,
+	}, nil
+}
+
+// representativePath returns the first same-site page link on the homepage,
+// skipping links to files
+func representativePath(base *url.URL, html string) string {
+	links := internalPageLinks(base, html, 1)
+	if len(links) == 0 {
+		return ""
+	}
+	return links[0].Path
+}
+
+// internalPageLinks returns up to limit distinct same-site page links in
+// html, resolved against base. Links to files (a dot in the last path
+// segment) and to the page itself are skipped.
+func internalPageLinks(base *url.URL, html string, limit int) []*url.URL {
+	var links []*url.URL
+	seen := map[string]bool{strings.TrimSuffix(base.Path, "/"): true}
+	for _, m := range internalLinkPattern.FindAllStringSubmatch(html, -1) {
+		link, err := base.Parse(strings.TrimSpace(m[1]))
+		if err != nil || link.Hostname() != base.Hostname() {
+			continue
+		}
+		if link.Scheme != "http" && link.Scheme != "https" {
+			continue
+		}
+		path := strings.TrimSuffix(link.Path, "/")
+		if path == "" || strings.Contains(lastSegment(path), ".") || seen[path] {
+			continue
+		}
+		seen[path] = true
+		links = append(links, link)
+		if len(links) >= limit {
+			break
+		}
+	}
+	return links
+}
+
+func lastSegment(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
+
+// findCanonical returns the rel=canonical URL in a page, or ""
+func findCanonical(body []byte) string {
+	m := canonicalPattern.FindSubmatch(body)
+	if m == nil {
+		return ""
+	}
+	if len(m[1]) > 0 {
+		return string(m[1])
+	}
+	return string(m[2])
+}
@@ -29,6 +29,7 @@ func (c VulnerabilityCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "No supported package manager detected for vulnerability scanning",
 		}, nil
 	}
@@ -40,6 +41,7 @@ func (c VulnerabilityCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  toolName + " not installed, skipping vulnerability check",
 			Suggestions: []string{
 				c.getInstallSuggestion(auditCmd),
@@ -47,8 +49,8 @@ func (c VulnerabilityCheck) Run(ctx Context) (CheckResult, error) {
 		}, nil
 	}
 
-	// Run the audit command
-	cmd := exec.Command(auditCmd, auditArgs...)
+	// Run the audit command; it is killed if the scan deadline runs out
+	cmd := exec.CommandContext(ctx, auditCmd, auditArgs...)
 	cmd.Dir = ctx.RootDir
 	output, err := cmd.CombinedOutput()
 
@@ -2,13 +2,17 @@ package checks
 
 import (
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 )
 
-// RobotsTxtCheck verifies robots.txt exists
+// RobotsTxtCheck verifies robots.txt exists and that its Sitemap
+// directives point to reachable sitemaps
 type RobotsTxtCheck struct{}
 
 func (c RobotsTxtCheck) ID() string {
@@ -19,27 +23,44 @@ func (c RobotsTxtCheck) Title() string {
 	return "robots.txt"
 }
 
+// Common web root directories across frameworks
+var robotsWebRoots = []string{
+	"public", // Laravel, Rails, many Node.js
+	"static", // Hugo, some SSGs
+	"web",    // Craft CMS, Symfony
+	"www",    // Some PHP apps
+	"dist",   // Built static sites
+	"build",  // Build outputs
+	"_site",  // Jekyll
+	"out",    // Next.js static export
+	"",       // Root directory
+}
+
 func (c RobotsTxtCheck) Run(ctx Context) (CheckResult, error) {
-	// Common web root directories across frameworks
-	webRoots := []string{
-		"public",  // Laravel, Rails, many Node.js
-		"static",  // Hugo, some SSGs
-		"web",     // Craft CMS, Symfony
-		"www",     // Some PHP apps
-		"dist",    // Built static sites
-		"build",   // Build outputs
-		"_site",   // Jekyll
-		"out",     // Next.js static export
-		"",        // Root directory
+	result, err := c.find(ctx)
+	if err != nil || !result.Passed {
+		return result, err
 	}
 
-	for _, root := range webRoots {
-		var path string
-		if root == "" {
-			path = "robots.txt"
-		} else {
-			path = root + "/robots.txt"
-		}
+	// Cross-check with the sitemap: a Sitemap directive crawlers can't
+	// follow is missed by both checks on their own
+	problems, details := checkSitemapDirectives(ctx)
+	result.Details = append(result.Details, details...)
+	if len(problems) > 0 {
+		result.Severity = SeverityWarn
+		result.Passed = false
+		result.Message = problems[0]
+		result.Suggestions = append(problems[1:],
+			"Use an absolute URL: Sitemap: https://example.com/sitemap.xml",
+			"Make sure the sitemap URL returns 200 with an XML content type",
+		)
+	}
+	return result, nil
+}
+
+// find locates a static or generated robots.txt
+func (c RobotsTxtCheck) find(ctx Context) (CheckResult, error) {
+	for _, path := range scopedPaths(ctx.RootDir, webRootPaths(robotsWebRoots, "robots.txt")) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if content, err := os.ReadFile(fullPath); err == nil {
 			// Check if it has meaningful content
@@ -93,7 +114,7 @@ func (c RobotsTxtCheck) Run(ctx Context) (CheckResult, error) {
 		"src/robots.txt.njk", "src/robots.txt.liquid", "robots.txt.njk", "robots.txt.liquid",
 	}
 
-	for _, path := range jsRobotsPaths {
+	for _, path := range scopedPaths(ctx.RootDir, jsRobotsPaths) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if _, err := os.Stat(fullPath); err == nil {
 			return CheckResult{
@@ -126,25 +147,29 @@ func (c RobotsTxtCheck) Run(ctx Context) (CheckResult, error) {
 	robotsFound := false
 	var robotsFoundPath string
 	flexRobotsDirs := []string{"app", "src/app", "pages/api", "src/routes", "server/routes"}
-	for _, dir := range flexRobotsDirs {
+	for _, dirPath := range scopedDirs(ctx.RootDir, flexRobotsDirs) {
 		if robotsFound {
 			break
 		}
-		dirPath := filepath.Join(ctx.RootDir, dir)
 		if _, err := os.Stat(dirPath); err != nil {
 			continue
 		}
 		filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+			if ctx.Err() != nil {
+				return ctx.Err() // the scan deadline ran out
+			}
 			if err != nil || robotsFound {
 				return nil
 			}
 			if info.IsDir() {
-				name := info.Name()
-				if name == "node_modules" || name == ".git" {
+				if shouldSkip(ctx.RootDir, path, true) {
 					return filepath.SkipDir
 				}
 				return nil
 			}
+			if shouldSkip(ctx.RootDir, path, false) {
+				return nil
+			}
 			nameLower := strings.ToLower(info.Name())
 			// Match robots.ts, robots.tsx, robots.js, robots.jsx
 			if nameLower == "robots.ts" || nameLower == "robots.tsx" || nameLower == "robots.js" || nameLower == "robots.jsx" {
@@ -185,6 +210,94 @@ func (c RobotsTxtCheck) Run(ctx Context) (CheckResult, error) {
 	}, nil
 }
 
+// checkSitemapDirectives validates the Sitemap: lines of the live
+// robots.txt, or of the static file when the site can't be fetched. Each URL
+// must be absolute and, when the network is available, return 200 with an
+// XML (or gzip/plain text) content type.
+func checkSitemapDirectives(ctx Context) (problems, details []string) {
+	source, content := liveRobotsTxt(ctx)
+	if source == "" {
+		for _, path := range scopedPaths(ctx.RootDir, webRootPaths(robotsWebRoots, "robots.txt")) {
+			if data, err := os.ReadFile(filepath.Join(ctx.RootDir, path)); err == nil {
+				source, content = path, string(data)
+				break
+			}
+		}
+	}
+	if source == "" {
+		return nil, nil
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(strings.ToLower(line), "sitemap:") {
+			continue
+		}
+		sitemapURL := strings.TrimSpace(line[len("sitemap:"):])
+		if sitemapURL == "" {
+			continue
+		}
+
+		parsed, err := url.Parse(sitemapURL)
+		if err != nil || !parsed.IsAbs() || parsed.Host == "" {
+			problems = append(problems, fmt.Sprintf("%s has a relative Sitemap URL (%s); crawlers only accept absolute URLs", source, sitemapURL))
+			continue
+		}
+
+		resp, err := doGet(ctx.Client, sitemapURL)
+		if err != nil {
+			// Offline, or the site isn't deployed yet
+			details = append(details, fmt.Sprintf("Sitemap: %s (not fetched: %v)", sitemapURL, err))
+			continue
+		}
+		resp.Body.Close()
+		contentType := resp.Header.Get("Content-Type")
+		details = append(details, fmt.Sprintf("Sitemap: %s (%d, %s)", sitemapURL, resp.StatusCode, contentType))
+		if resp.StatusCode != http.StatusOK {
+			problems = append(problems, fmt.Sprintf("%s points to %s, which returns %d", source, sitemapURL, resp.StatusCode))
+		} else if !isSitemapContentType(contentType, sitemapURL) {
+			problems = append(problems, fmt.Sprintf("%s points to %s, which is served as %q, not XML", source, sitemapURL, contentType))
+		}
+	}
+	return problems, details
+}
+
+// liveRobotsTxt fetches robots.txt from the production (or staging) URL,
+// returning its URL and content, or "" when unavailable
+func liveRobotsTxt(ctx Context) (string, string) {
+	baseURL := ctx.Config.URLs.Production
+	if baseURL == "" {
+		baseURL = ctx.Config.URLs.Staging
+	}
+	if baseURL == "" {
+		return "", ""
+	}
+	resp, actualURL, err := tryURL(ctx.Client, strings.TrimSuffix(siteRoot(ctx, baseURL), "/")+"/robots.txt")
+	if err != nil {
+		return "", ""
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", ""
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512<<10))
+	return actualURL, string(body)
+}
+
+// isSitemapContentType accepts XML sitemaps, gzipped sitemaps, and plain
+// text URL lists
+func isSitemapContentType(contentType, sitemapURL string) bool {
+	contentType = strings.ToLower(contentType)
+	if strings.Contains(contentType, "xml") {
+		return true
+	}
+	path := strings.ToLower(sitemapURL)
+	if strings.HasSuffix(path, ".gz") {
+		return strings.Contains(contentType, "gzip") || strings.Contains(contentType, "octet-stream")
+	}
+	return strings.HasSuffix(path, ".txt") && strings.Contains(contentType, "text/plain")
+}
+
 // SitemapCheck verifies sitemap.xml exists
 type SitemapCheck struct{}
 
@@ -210,13 +323,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 		"",        // Root directory
 	}
 
-	for _, root := range webRoots {
-		var path string
-		if root == "" {
-			path = "sitemap.xml"
-		} else {
-			path = root + "/sitemap.xml"
-		}
+	for _, path := range scopedPaths(ctx.RootDir, webRootPaths(webRoots, "sitemap.xml")) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if content, err := os.ReadFile(fullPath); err == nil {
 			// Check if it has meaningful content
@@ -273,7 +380,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 		"src/sitemap.11ty.js", "sitemap.11ty.js",
 	}
 
-	for _, path := range jsSitemapPaths {
+	for _, path := range scopedPaths(ctx.RootDir, jsSitemapPaths) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if _, err := os.Stat(fullPath); err == nil {
 			return CheckResult{
@@ -306,25 +413,29 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	sitemapFound := false
 	var sitemapFoundPath string
 	flexSitemapDirs := []string{"app", "src/app", "pages/api", "src/routes", "server/routes"}
-	for _, dir := range flexSitemapDirs {
+	for _, dirPath := range scopedDirs(ctx.RootDir, flexSitemapDirs) {
 		if sitemapFound {
 			break
 		}
-		dirPath := filepath.Join(ctx.RootDir, dir)
 		if _, err := os.Stat(dirPath); err != nil {
 			continue
 		}
 		filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+			if ctx.Err() != nil {
+				return ctx.Err() // the scan deadline ran out
+			}
 			if err != nil || sitemapFound {
 				return nil
 			}
 			if info.IsDir() {
-				name := info.Name()
-				if name == "node_modules" || name == ".git" {
+				if shouldSkip(ctx.RootDir, path, true) {
 					return filepath.SkipDir
 				}
 				return nil
 			}
+			if shouldSkip(ctx.RootDir, path, false) {
+				return nil
+			}
 			nameLower := strings.ToLower(info.Name())
 			// Match sitemap.ts, sitemap.tsx, sitemap.js, sitemap.jsx
 			if nameLower == "sitemap.ts" || nameLower == "sitemap.tsx" || nameLower == "sitemap.js" || nameLower == "sitemap.jsx" {
@@ -380,7 +491,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 		"Controllers/SitemapController.cs",
 	}
 
-	for _, path := range dynamicSitemapPaths {
+	for _, path := range scopedPaths(ctx.RootDir, dynamicSitemapPaths) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if _, err := os.Stat(fullPath); err == nil {
 			return CheckResult{
@@ -402,7 +513,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 		"resources/views/sitemap",
 	}
 
-	for _, path := range sitemapViewDirs {
+	for _, path := range scopedPaths(ctx.RootDir, sitemapViewDirs) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if info, err := os.Stat(fullPath); err == nil && info.IsDir() {
 			return CheckResult{
@@ -421,7 +532,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 		"config/urls.py",
 		"project/urls.py",
 	}
-	for _, path := range djangoUrlsPaths {
+	for _, path := range scopedPaths(ctx.RootDir, djangoUrlsPaths) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if content, err := os.ReadFile(fullPath); err == nil {
 			if strings.Contains(string(content), "sitemap") {
@@ -437,8 +548,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// Check for sitemap generation in package.json (Node/Next.js)
-	pkgPath := filepath.Join(ctx.RootDir, "package.json")
-	if content, err := os.ReadFile(pkgPath); err == nil {
+		if content, err := readScoped(ctx.RootDir, "package.json"); err == nil {
 		if strings.Contains(string(content), "next-sitemap") ||
 			strings.Contains(string(content), "sitemap") {
 			return CheckResult{
@@ -452,8 +562,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// Check for sitemap in Gemfile (Rails)
-	gemfilePath := filepath.Join(ctx.RootDir, "Gemfile")
-	if content, err := os.ReadFile(gemfilePath); err == nil {
+		if content, err := readScoped(ctx.RootDir, "Gemfile"); err == nil {
 		if strings.Contains(string(content), "sitemap_generator") ||
 			strings.Contains(string(content), "sitemap") {
 			return CheckResult{
@@ -467,8 +576,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// Check for sitemap in composer.json (Laravel/PHP)
-	composerPath := filepath.Join(ctx.RootDir, "composer.json")
-	if content, err := os.ReadFile(composerPath); err == nil {
+		if content, err := readScoped(ctx.RootDir, "composer.json"); err == nil {
 		if strings.Contains(string(content), "spatie/laravel-sitemap") ||
 			strings.Contains(string(content), "sitemap") {
 			return CheckResult{
@@ -482,8 +590,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// Check for sitemap in requirements.txt (Python/Flask/Django)
-	requirementsPath := filepath.Join(ctx.RootDir, "requirements.txt")
-	if content, err := os.ReadFile(requirementsPath); err == nil {
+		if content, err := readScoped(ctx.RootDir, "requirements.txt"); err == nil {
 		if strings.Contains(string(content), "django-sitemap") ||
 			strings.Contains(string(content), "flask-sitemap") ||
 			strings.Contains(string(content), "sitemap") {
@@ -506,7 +613,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 		"wp-content/plugins/seo-by-rank-math",     // Rank Math
 		"wp-content/plugins/google-sitemap-generator", // Google XML Sitemaps
 	}
-	for _, dir := range wpPluginDirs {
+	for _, dir := range scopedPaths(ctx.RootDir, wpPluginDirs) {
 		fullPath := filepath.Join(ctx.RootDir, dir)
 		if info, err := os.Stat(fullPath); err == nil && info.IsDir() {
 			return CheckResult{
@@ -520,8 +627,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// Craft CMS: Check for SEO plugins in composer.json
-	craftComposerPath := filepath.Join(ctx.RootDir, "composer.json")
-	if content, err := os.ReadFile(craftComposerPath); err == nil {
+		if content, err := readScoped(ctx.RootDir, "composer.json"); err == nil {
 		// Check for Craft CMS SEO plugins that generate sitemaps
 		craftSeoPlugins := []string{
 			"nystudio107/craft-seomatic",
@@ -546,7 +652,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 		"config/seomatic.php",
 		"config/sitemap.php",
 	}
-	for _, path := range craftSitemapPaths {
+	for _, path := range scopedPaths(ctx.RootDir, craftSitemapPaths) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if _, err := os.Stat(fullPath); err == nil {
 			return CheckResult{
@@ -561,7 +667,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 
 	// Hugo: Check hugo config for sitemap settings (Hugo has built-in sitemap)
 	hugoConfigs := []string{"hugo.toml", "hugo.yaml", "hugo.json", "config.toml", "config.yaml"}
-	for _, cfg := range hugoConfigs {
+	for _, cfg := range scopedPaths(ctx.RootDir, hugoConfigs) {
 		fullPath := filepath.Join(ctx.RootDir, cfg)
 		if _, err := os.Stat(fullPath); err == nil {
 			// Hugo generates sitemap by default
@@ -576,8 +682,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// Jekyll: Check for jekyll-sitemap in _config.yml or Gemfile
-	jekyllConfig := filepath.Join(ctx.RootDir, "_config.yml")
-	if content, err := os.ReadFile(jekyllConfig); err == nil {
+		if content, err := readScoped(ctx.RootDir, "_config.yml"); err == nil {
 		if strings.Contains(string(content), "jekyll-sitemap") {
 			return CheckResult{
 				ID:       c.ID(),
@@ -590,8 +695,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// Gatsby: Check for gatsby-plugin-sitemap
-	gatsbyConfig := filepath.Join(ctx.RootDir, "gatsby-config.js")
-	if content, err := os.ReadFile(gatsbyConfig); err == nil {
+		if content, err := readScoped(ctx.RootDir, "gatsby-config.js"); err == nil {
 		if strings.Contains(string(content), "gatsby-plugin-sitemap") {
 			return CheckResult{
 				ID:       c.ID(),
@@ -605,7 +709,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 
 	// Astro: Check for @astrojs/sitemap
 	astroConfigs := []string{"astro.config.mjs", "astro.config.ts", "astro.config.js"}
-	for _, cfg := range astroConfigs {
+	for _, cfg := range scopedPaths(ctx.RootDir, astroConfigs) {
 		fullPath := filepath.Join(ctx.RootDir, cfg)
 		if content, err := os.ReadFile(fullPath); err == nil {
 			if strings.Contains(string(content), "@astrojs/sitemap") || strings.Contains(string(content), "sitemap") {
@@ -622,7 +726,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 
 	// Nuxt: Check for @nuxtjs/sitemap module
 	nuxtConfigs := []string{"nuxt.config.ts", "nuxt.config.js"}
-	for _, cfg := range nuxtConfigs {
+	for _, cfg := range scopedPaths(ctx.RootDir, nuxtConfigs) {
 		fullPath := filepath.Join(ctx.RootDir, cfg)
 		if content, err := os.ReadFile(fullPath); err == nil {
 			if strings.Contains(string(content), "@nuxtjs/sitemap") || strings.Contains(string(content), "sitemap") {
@@ -638,8 +742,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// SvelteKit: Check for sitemap in svelte.config.js
-	svelteConfig := filepath.Join(ctx.RootDir, "svelte.config.js")
-	if content, err := os.ReadFile(svelteConfig); err == nil {
+		if content, err := readScoped(ctx.RootDir, "svelte.config.js"); err == nil {
 		if strings.Contains(string(content), "sitemap") {
 			return CheckResult{
 				ID:       c.ID(),
@@ -653,7 +756,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 
 	// Eleventy: Check for sitemap in .eleventy.js or eleventy.config.js
 	eleventyConfigs := []string{".eleventy.js", "eleventy.config.js", "eleventy.config.cjs", "eleventy.config.mjs"}
-	for _, cfg := range eleventyConfigs {
+	for _, cfg := range scopedPaths(ctx.RootDir, eleventyConfigs) {
 		fullPath := filepath.Join(ctx.RootDir, cfg)
 		if content, err := os.ReadFile(fullPath); err == nil {
 			if strings.Contains(string(content), "sitemap") {
@@ -669,8 +772,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// Ghost: Built-in sitemap (Ghost always has /sitemap.xml)
-	ghostConfig := filepath.Join(ctx.RootDir, "content/themes")
-	if info, err := os.Stat(ghostConfig); err == nil && info.IsDir() {
+		if info, err := statScoped(ctx.RootDir, "content/themes"); err == nil && info.IsDir() {
 		return CheckResult{
 			ID:       c.ID(),
 			Title:    c.Title(),
@@ -681,8 +783,7 @@ func (c SitemapCheck) Run(ctx Context) (CheckResult, error) {
 	}
 
 	// Drupal: Check for sitemap module
-	drupalModules := filepath.Join(ctx.RootDir, "modules/contrib/simple_sitemap")
-	if info, err := os.Stat(drupalModules); err == nil && info.IsDir() {
+		if info, err := statScoped(ctx.RootDir, "modules/contrib/simple_sitemap"); err == nil && info.IsDir() {
 		return CheckResult{
 			ID:       c.ID(),
 			Title:    c.Title(),
@@ -717,6 +818,18 @@ func (c LLMsTxtCheck) Title() string {
 }
 
 func (c LLMsTxtCheck) Run(ctx Context) (CheckResult, error) {
+	// With a live site, validate what is actually served. If it can't be
+	// fetched, fall back to the project files for pre-deploy scans.
+	baseURL := ctx.Config.URLs.Production
+	if baseURL == "" {
+		baseURL = ctx.Config.URLs.Staging
+	}
+	if baseURL != "" {
+		if result, ok := c.checkRemote(ctx, baseURL); ok {
+			return result, nil
+		}
+	}
+
 	// Common web root directories across frameworks
 	webRoots := []string{
 		"public",  // Laravel, Rails, many Node.js
@@ -738,20 +851,10 @@ func (c LLMsTxtCheck) Run(ctx Context) (CheckResult, error) {
 		} else {
 			paths = []string{root + "/llms.txt", root + "/.well-known/llms.txt"}
 		}
-		for _, path := range paths {
+		for _, path := range scopedPaths(ctx.RootDir, paths) {
 			fullPath := filepath.Join(ctx.RootDir, path)
 			if content, err := os.ReadFile(fullPath); err == nil {
-				// Check if it has meaningful content
-				contentStr := strings.TrimSpace(string(content))
-				if len(contentStr) > 0 {
-					return CheckResult{
-						ID:       c.ID(),
-						Title:    c.Title(),
-						Severity: SeverityInfo,
-						Passed:   true,
-						Message:  "llms.txt found at " + path,
-					}, nil
-				}
+				return c.validate(path, string(content)), nil
 			}
 		}
 	}
@@ -760,17 +863,8 @@ func (c LLMsTxtCheck) Run(ctx Context) (CheckResult, error) {
 	monorepoPublicPaths := findMonorepoPublicFiles(ctx.RootDir, "llms.txt")
 	for _, path := range monorepoPublicPaths {
 		if content, err := os.ReadFile(path); err == nil {
-			contentStr := strings.TrimSpace(string(content))
-			if len(contentStr) > 0 {
-				relPath, _ := filepath.Rel(ctx.RootDir, path)
-				return CheckResult{
-					ID:       c.ID(),
-					Title:    c.Title(),
-					Severity: SeverityInfo,
-					Passed:   true,
-					Message:  "llms.txt found at " + relPath,
-				}, nil
-			}
+			relPath, _ := filepath.Rel(ctx.RootDir, path)
+			return c.validate(relPath, string(content)), nil
 		}
 	}
 
@@ -796,7 +890,7 @@ func (c LLMsTxtCheck) Run(ctx Context) (CheckResult, error) {
 		"src/llms.txt.njk", "src/llms.txt.liquid", "llms.txt.njk", "llms.txt.liquid",
 	}
 
-	for _, path := range jsLLMsPaths {
+	for _, path := range scopedPaths(ctx.RootDir, jsLLMsPaths) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if _, err := os.Stat(fullPath); err == nil {
 			return CheckResult{
@@ -842,7 +936,7 @@ func (c LLMsTxtCheck) Run(ctx Context) (CheckResult, error) {
 		"src/routes/llms.js", "src/routes/llms.ts",
 	}
 
-	for _, path := range backendLLMsPaths {
+	for _, path := range scopedPaths(ctx.RootDir, backendLLMsPaths) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if _, err := os.Stat(fullPath); err == nil {
 			return CheckResult{
@@ -859,25 +953,29 @@ func (c LLMsTxtCheck) Run(ctx Context) (CheckResult, error) {
 	llmsFound := false
 	var llmsFoundPath string
 	flexLLMsDirs := []string{"app", "src/app", "pages/api", "src/routes", "server/routes"}
-	for _, dir := range flexLLMsDirs {
+	for _, dirPath := range scopedDirs(ctx.RootDir, flexLLMsDirs) {
 		if llmsFound {
 			break
 		}
-		dirPath := filepath.Join(ctx.RootDir, dir)
 		if _, err := os.Stat(dirPath); err != nil {
 			continue
 		}
 		filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+			if ctx.Err() != nil {
+				return ctx.Err() // the scan deadline ran out
+			}
 			if err != nil || llmsFound {
 				return nil
 			}
 			if info.IsDir() {
-				name := info.Name()
-				if name == "node_modules" || name == ".git" {
+				if shouldSkip(ctx.RootDir, path, true) {
 					return filepath.SkipDir
 				}
 				return nil
 			}
+			if shouldSkip(ctx.RootDir, path, false) {
+				return nil
+			}
 			nameLower := strings.ToLower(info.Name())
 			parentDir := strings.ToLower(filepath.Base(filepath.Dir(path)))
 			// Match route.ts/js in llms.txt/ or llms/ directory
@@ -918,6 +1016,156 @@ func (c LLMsTxtCheck) Run(ctx Context) (CheckResult, error) {
 	}, nil
 }
 
+// checkRemote fetches <baseURL>/llms.txt and validates it. ok is false when
+// the site is unreachable or doesn't serve the file yet, so the caller can
+// fall back to the local files.
+func (c LLMsTxtCheck) checkRemote(ctx Context, baseURL string) (CheckResult, bool) {
+	llmsURL := strings.TrimSuffix(siteRoot(ctx, baseURL), "/") + "/llms.txt"
+	resp, actualURL, err := tryURL(ctx.Client, llmsURL)
+	if err != nil {
+		return CheckResult{}, false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return CheckResult{}, false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 512*1024))
+	if err != nil {
+		return CheckResult{}, false
+	}
+
+	// Catch-all routes often answer unknown paths with the app's HTML 404 page
+	if strings.Contains(resp.Header.Get("Content-Type"), "text/html") || looksLikeHTML(string(body)) {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  actualURL + " returned an HTML page instead of llms.txt",
+			Suggestions: []string{
+				"Serve llms.txt as plain text Markdown at the site root",
+				"Check that a catch-all route isn't answering /llms.txt with a 404 page",
+			},
+		}, true
+	}
+
+	return c.validate(actualURL, string(body)), true
+}
+
+var (
+	llmsSectionPattern = regexp.MustCompile(`^##\s+(.+)`)
+	llmsLinkPattern    = regexp.MustCompile(`\[[^\]]+\]\([^)]+\)`)
+)
+
+// validate checks the basic llms.txt structure from https://llmstxt.org:
+// a top-level # title and at least one ## section containing links
+func (c LLMsTxtCheck) validate(source, content string) CheckResult {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "llms.txt at " + source + " is empty",
+			Suggestions: []string{
+				"Add a # title, a short summary, and ## sections linking to your key pages",
+				"See https://llmstxt.org for specification",
+			},
+		}
+	}
+	if looksLikeHTML(content) {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "llms.txt at " + source + " contains HTML instead of Markdown",
+			Suggestions: []string{
+				"Write llms.txt as plain Markdown",
+				"See https://llmstxt.org for specification",
+			},
+		}
+	}
+
+	hasTitle := false
+	var sections []string
+	var details []string
+	linksInSection, sectionsWithLinks := 0, 0
+	flushSection := func() {
+		if len(sections) > 0 {
+			details = append(details, fmt.Sprintf("Section: %s (%d links)", sections[len(sections)-1], linksInSection))
+		}
+	}
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if i == 0 && strings.HasPrefix(line, "# ") {
+			hasTitle = true
+			continue
+		}
+		if m := llmsSectionPattern.FindStringSubmatch(line); m != nil {
+			flushSection()
+			sections = append(sections, strings.TrimSpace(m[1]))
+			linksInSection = 0
+			continue
+		}
+		if len(sections) > 0 && llmsLinkPattern.MatchString(line) {
+			if linksInSection == 0 {
+				sectionsWithLinks++
+			}
+			linksInSection++
+		}
+	}
+	flushSection()
+
+	if !hasTitle {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "llms.txt at " + source + " doesn't start with a # title",
+			Suggestions: []string{
+				"Start llms.txt with a top-level heading naming your site, e.g. # Acme",
+			},
+			Details: details,
+		}
+	}
+	if sectionsWithLinks == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "llms.txt at " + source + " has no ## section with links",
+			Suggestions: []string{
+				"Add sections such as ## Docs with Markdown links: - [Title](https://...): description",
+			},
+			Details: details,
+		}
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  fmt.Sprintf("llms.txt found at %s (%d sections)", source, len(sections)),
+		Details:  details,
+	}
+}
+
+// looksLikeHTML reports whether text starts like an HTML document
+func looksLikeHTML(text string) bool {
+	start := strings.ToLower(strings.TrimSpace(text))
+	if len(start) > 64 {
+		start = start[:64]
+	}
+	return strings.HasPrefix(start, "<!doctype html") || strings.HasPrefix(start, "<html")
+}
+
 // AdsTxtCheck verifies ads.txt exists (optional, for ad-supported sites)
 type AdsTxtCheck struct{}
 
@@ -938,6 +1186,7 @@ func (c AdsTxtCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "ads.txt check not enabled",
 		}, nil
 	}
@@ -955,26 +1204,10 @@ func (c AdsTxtCheck) Run(ctx Context) (CheckResult, error) {
 		"",        // Root directory
 	}
 
-	for _, root := range webRoots {
-		var path string
-		if root == "" {
-			path = "ads.txt"
-		} else {
-			path = root + "/ads.txt"
-		}
+	for _, path := range scopedPaths(ctx.RootDir, webRootPaths(webRoots, "ads.txt")) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if content, err := os.ReadFile(fullPath); err == nil {
-			// Check if it has meaningful content
-			contentStr := strings.TrimSpace(string(content))
-			if len(contentStr) > 0 {
-				return CheckResult{
-					ID:       c.ID(),
-					Title:    c.Title(),
-					Severity: SeverityInfo,
-					Passed:   true,
-					Message:  "ads.txt found at " + path,
-				}, nil
-			}
+			return c.validate(path, string(content)), nil
 		}
 	}
 
@@ -991,6 +1224,95 @@ func (c AdsTxtCheck) Run(ctx Context) (CheckResult, error) {
 	}, nil
 }
 
+var (
+	adsTxtDomainPattern   = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)+$`)
+	adsTxtVariablePattern = regexp.MustCompile(`^(?i)(contact|subdomain|ownerdomain|managerdomain|inventorypartnerdomain)\s*=`)
+	adsTxtCertPattern     = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+)
+
+// validate parses ads.txt records in the IAB format:
+// <ad system domain>, <publisher ID>, <DIRECT|RESELLER>[, <cert authority ID>]
+func (c AdsTxtCheck) validate(path, content string) CheckResult {
+	valid := 0
+	var malformed []string
+	for i, line := range strings.Split(content, "\n") {
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" || adsTxtVariablePattern.MatchString(line) {
+			continue
+		}
+		if problem := adsTxtRecordProblem(line); problem != "" {
+			malformed = append(malformed, fmt.Sprintf("Line %d: %s", i+1, problem))
+			continue
+		}
+		valid++
+	}
+
+	if valid == 0 && len(malformed) == 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "ads.txt at " + path + " has no records",
+			Suggestions: []string{
+				"Add a line per authorized seller: example.com, pub-0000000000000000, DIRECT, f08c47fec0942fa0",
+			},
+		}
+	}
+
+	details := []string{fmt.Sprintf("%d valid record(s)", valid)}
+	if len(malformed) > 0 {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("ads.txt at %s has %d malformed line(s)", path, len(malformed)),
+			Suggestions: []string{
+				"Use the format: <ad system domain>, <publisher ID>, <DIRECT|RESELLER>[, <cert authority ID>]",
+				"Ad exchanges ignore malformed lines, which can cost revenue",
+			},
+			Details: append(details, malformed...),
+		}
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  fmt.Sprintf("ads.txt found at %s (%d records)", path, valid),
+		Details:  details,
+	}
+}
+
+// adsTxtRecordProblem describes what is wrong with one ads.txt record, or "" if it is valid
+func adsTxtRecordProblem(line string) string {
+	fields := strings.Split(line, ",")
+	if len(fields) < 3 || len(fields) > 4 {
+		return fmt.Sprintf("expected 3 or 4 comma-separated fields, found %d", len(fields))
+	}
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	if !adsTxtDomainPattern.MatchString(fields[0]) {
+		return fmt.Sprintf("invalid ad system domain %q", fields[0])
+	}
+	if fields[1] == "" {
+		return "missing publisher ID"
+	}
+	if rel := strings.ToUpper(fields[2]); rel != "DIRECT" && rel != "RESELLER" {
+		return fmt.Sprintf("relationship must be DIRECT or RESELLER, found %q", fields[2])
+	}
+	if len(fields) == 4 && !adsTxtCertPattern.MatchString(fields[3]) {
+		return fmt.Sprintf("invalid certification authority ID %q", fields[3])
+	}
+	return ""
+}
+
 // IndexNowCheck verifies IndexNow key file exists with correct content
 type IndexNowCheck struct{}
 
@@ -1010,12 +1332,23 @@ func (c IndexNowCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "IndexNow check not enabled",
 		}, nil
 	}
 
 	key := ctx.Config.Checks.IndexNow.Key
 
+	// With a live site, verify the key file is actually served where search engines look
+	baseURL := ctx.Config.URLs.Production
+	if baseURL == "" {
+		baseURL = ctx.Config.URLs.Staging
+	}
+	remote := baseURL != "" && !ctx.Offline()
+	if key != "" && remote {
+		return c.checkRemote(ctx, baseURL, key, "the configured key")
+	}
+
 	// Common web root directories across frameworks
 	webRoots := []string{
 		"public",  // Laravel, Rails, many Node.js
@@ -1038,7 +1371,7 @@ func (c IndexNowCheck) Run(ctx Context) (CheckResult, error) {
 			} else {
 				paths = []string{root + "/" + key + ".txt", root + "/.well-known/" + key + ".txt"}
 			}
-			for _, path := range paths {
+			for _, path := range scopedPaths(ctx.RootDir, paths) {
 				fullPath := filepath.Join(ctx.RootDir, path)
 				if content, err := os.ReadFile(fullPath); err == nil {
 					contentStr := strings.TrimSpace(string(content))
@@ -1058,7 +1391,7 @@ func (c IndexNowCheck) Run(ctx Context) (CheckResult, error) {
 
 	// Also look for any valid IndexNow key file (32-char hex filename)
 	hexPattern := regexp.MustCompile(`^[a-f0-9]{32}\.txt$`)
-	for _, root := range webRoots {
+	for _, root := range scopedPaths(ctx.RootDir, webRoots) {
 		dir := filepath.Join(ctx.RootDir, root)
 		entries, err := os.ReadDir(dir)
 		if err != nil {
@@ -1083,6 +1416,10 @@ func (c IndexNowCheck) Run(ctx Context) (CheckResult, error) {
 							Message:  fmt.Sprintf("IndexNow key file found at %s (update preflight.yml key to: %s)", path, foundKey),
 						}, nil
 					}
+					// A key file in the repo only helps if the live site serves it
+					if remote {
+						return c.checkRemote(ctx, baseURL, foundKey, "the key in "+path)
+					}
 					return CheckResult{
 						ID:       c.ID(),
 						Title:    c.Title(),
@@ -1121,6 +1458,75 @@ func (c IndexNowCheck) Run(ctx Context) (CheckResult, error) {
 	}, nil
 }
 
+// checkRemote fetches <baseURL>/<key>.txt and verifies it returns the key;
+// source says where the key came from for the mismatch message
+func (c IndexNowCheck) checkRemote(ctx Context, baseURL, key, source string) (CheckResult, error) {
+	keyURL := strings.TrimSuffix(siteRoot(ctx, baseURL), "/") + "/" + key + ".txt"
+	details := []string{"Probed " + keyURL}
+
+	resp, actualURL, err := tryURL(ctx.Client, keyURL)
+	if err != nil {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "IndexNow key file could not be fetched",
+			Suggestions: []string{
+				"Ensure the site is reachable and serves " + key + ".txt at its root",
+			},
+			Details: append(details, "Error: "+err.Error()),
+		}, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  fmt.Sprintf("IndexNow key file returned %d at %s", resp.StatusCode, actualURL),
+			Suggestions: []string{
+				fmt.Sprintf("Deploy %s.txt to your web root containing: %s", key, key),
+				"IndexNow submissions fail silently when the key file is missing",
+			},
+			Details: append(details, fmt.Sprintf("Status: %d", resp.StatusCode)),
+		}, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return CheckResult{}, err
+	}
+	served := strings.TrimSpace(string(body))
+	if served != key {
+		return CheckResult{
+			ID:       c.ID(),
+			Title:    c.Title(),
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  "IndexNow key file content does not match " + source,
+			Suggestions: []string{
+				fmt.Sprintf("Make %s contain only: %s", actualURL, key),
+			},
+			Details: append(details,
+				"Expected: "+key,
+				"Served: "+truncate(served, 64),
+			),
+		}, nil
+	}
+
+	return CheckResult{
+		ID:       c.ID(),
+		Title:    c.Title(),
+		Severity: SeverityInfo,
+		Passed:   true,
+		Message:  "IndexNow key file served at " + actualURL,
+		Details:  details,
+	}, nil
+}
+
 // HumansTxtCheck verifies humans.txt exists (optional, credits the team)
 type HumansTxtCheck struct{}
 
@@ -1139,19 +1545,14 @@ func (c HumansTxtCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "humans.txt check not enabled",
 		}, nil
 	}
 
 	webRoots := []string{"public", "static", "web", "www", "dist", "build", "_site", "out", ""}
 
-	for _, root := range webRoots {
-		var path string
-		if root == "" {
-			path = "humans.txt"
-		} else {
-			path = root + "/humans.txt"
-		}
+	for _, path := range scopedPaths(ctx.RootDir, webRootPaths(webRoots, "humans.txt")) {
 		fullPath := filepath.Join(ctx.RootDir, path)
 		if content, err := os.ReadFile(fullPath); err == nil {
 			contentStr := strings.TrimSpace(string(content))
@@ -1183,6 +1584,9 @@ func (c HumansTxtCheck) Run(ctx Context) (CheckResult, error) {
 // findMonorepoNextFiles searches for files in monorepo structures with Next.js App Router
 // convention (apps/*/src/app/, packages/*/src/app/, apps/*/app/)
 func findMonorepoNextFiles(rootDir string, filenames []string) []string {
+	if scoped(rootDir) {
+		return nil
+	}
 	var paths []string
 
 	monorepoRoots := []string{"apps", "packages", "services"}
@@ -1214,9 +1618,22 @@ func findMonorepoNextFiles(rootDir string, filenames []string) []string {
 	return paths
 }
 
+// webRootPaths lists filename in each web root ("" is the project root)
+func webRootPaths(roots []string, filename string) []string {
+	paths := make([]string, 0, len(roots))
+	for _, root := range roots {
+		paths = append(paths, filepath.ToSlash(filepath.Join(root, filename)))
+	}
+	return paths
+}
+
 // findMonorepoPublicFiles searches for static files in monorepo public directories
-// (apps/*/public/, packages/*/public/, etc.)
+// (apps/*/public/, packages/*/public/, etc.). There is none to search when
+// file checks are scoped to paths.
 func findMonorepoPublicFiles(rootDir, filename string) []string {
+	if scoped(rootDir) {
+		return nil
+	}
 	var paths []string
 
 	monorepoRoots := []string{"apps", "packages", "services"}
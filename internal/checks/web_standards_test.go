@@ -0,0 +1,120 @@
+package checks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/preflightsh/preflight/internal/config"
+)
+
+// twoAppRepo has a web root file only in apps/web, plus a root public/ copy
+// that belongs to neither app
+func twoAppRepo(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		"apps/web/public/robots.txt":  "User-agent: *\nAllow: /\n",
+		"apps/web/public/sitemap.xml": "<urlset></urlset>\n",
+		"apps/admin/package.json":     "{}\n",
+		"public/robots.txt":           "User-agent: *\n",
+		"public/sitemap.xml":          "<urlset></urlset>\n",
+	}
+	for rel, content := range files {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestWebRootFilesFollowPaths(t *testing.T) {
+	for _, check := range []Check{RobotsTxtCheck{}, SitemapCheck{}} {
+		t.Run(check.ID(), func(t *testing.T) {
+			root := twoAppRepo(t)
+
+			// Scoped to the app that has the file: found in that app
+			ctx := Context{Context: context.Background(), RootDir: root, Config: &config.PreflightConfig{}, Files: NewFileIndex(root, "apps/web")}
+			result, err := check.Run(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !result.Passed || !strings.Contains(result.Message, "apps/web/public/") {
+				t.Errorf("scoped to apps/web: %+v, want it found in apps/web/public", result)
+			}
+
+			// Scoped to the other app: neither apps/web's nor the root copy counts
+			ctx.Files = NewFileIndex(root, "apps/admin")
+			result, err = check.Run(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Passed {
+				t.Errorf("scoped to apps/admin: %+v, want not found", result)
+			}
+		})
+	}
+}
+
+func TestIndexNowVerifiesDiscoveredKey(t *testing.T) {
+	const key = "0123456789abcdef0123456789abcdef"
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "public"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "public", key+".txt"), []byte(key+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		served string
+		passed bool
+	}{
+		{"served", key, true},
+		{"stale", "fedcba9876543210fedcba9876543210", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/"+key+".txt" {
+					http.NotFound(w, r)
+					return
+				}
+				w.Write([]byte(tt.served))
+			}))
+			defer server.Close()
+
+			cfg := &config.PreflightConfig{}
+			cfg.URLs.Production = server.URL
+			cfg.Checks.IndexNow = &config.IndexNowConfig{Enabled: true}
+			result, err := IndexNowCheck{}.Run(Context{
+				Context: context.Background(),
+				RootDir: root,
+				Config:  cfg,
+				Client:  server.Client(),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Passed != tt.passed {
+				t.Fatalf("Passed = %v, want %v: %s", result.Passed, tt.passed, result.Message)
+			}
+			details := strings.Join(result.Details, "\n")
+			if !strings.Contains(details, "Probed "+server.URL+"/"+key+".txt") {
+				t.Errorf("Details %q do not name the probed URL", details)
+			}
+			if !tt.passed && !strings.Contains(details, "Served: "+tt.served) {
+				t.Errorf("Details %q do not show the served content", details)
+			}
+		})
+	}
+}
@@ -1,11 +1,12 @@
 package checks
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strings"
-	"time"
 )
 
 type WWWRedirectCheck struct{}
@@ -18,6 +19,14 @@ func (c WWWRedirectCheck) Title() string {
 	return "WWW redirect"
 }
 
+func (c WWWRedirectCheck) RequiresNetwork() bool {
+	return true
+}
+
+func (c WWWRedirectCheck) DependsOn() []string {
+	return siteDependencies
+}
+
 func (c WWWRedirectCheck) Run(ctx Context) (CheckResult, error) {
 	if ctx.Config.URLs.Production == "" {
 		return CheckResult{
@@ -25,6 +34,7 @@ func (c WWWRedirectCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "No production URL configured",
 		}, nil
 	}
@@ -49,6 +59,7 @@ func (c WWWRedirectCheck) Run(ctx Context) (CheckResult, error) {
 			Title:    c.Title(),
 			Severity: SeverityInfo,
 			Passed:   true,
+			Skipped:  true,
 			Message:  "Skipped for local URL",
 		}, nil
 	}
@@ -72,8 +83,8 @@ func (c WWWRedirectCheck) Run(ctx Context) (CheckResult, error) {
 	nonWwwURL := scheme + "://" + nonWwwHost
 
 	// Check both URLs
-	wwwFinal, wwwErr := getFinalURL(wwwURL)
-	nonWwwFinal, nonWwwErr := getFinalURL(nonWwwURL)
+	wwwFinal, wwwErr := getFinalURL(ctx.Client, wwwURL)
+	nonWwwFinal, nonWwwErr := getFinalURL(ctx.Client, nonWwwURL)
 
 	// Both fail to resolve
 	if wwwErr != nil && nonWwwErr != nil {
@@ -119,6 +130,14 @@ func (c WWWRedirectCheck) Run(ctx Context) (CheckResult, error) {
 		}, nil
 	}
 
+	// Inspect the first hop of each so the redirect status and target are known
+	wwwHop, wwwHopErr := probeNoFollow(ctx.Client, wwwURL)
+	nonWwwHop, nonWwwHopErr := probeNoFollow(ctx.Client, nonWwwURL)
+	details := []string{
+		describeHop(wwwHost, wwwHop, wwwHopErr),
+		describeHop(nonWwwHost, nonWwwHop, nonWwwHopErr),
+	}
+
 	// Both resolve - check if they end up at the same domain
 	wwwFinalHost := extractHost(wwwFinal)
 	nonWwwFinalHost := extractHost(nonWwwFinal)
@@ -131,24 +150,66 @@ func (c WWWRedirectCheck) Run(ctx Context) (CheckResult, error) {
 		// Both end up at the same domain (with or without www)
 		if wwwFinalHost == nonWwwFinalHost {
 			canonical := "non-www"
+			fromHost, hop := nonWwwHost, nonWwwHop
 			if strings.HasPrefix(wwwFinalHost, "www.") {
 				canonical = "www"
+			} else {
+				fromHost, hop = wwwHost, wwwHop
 			}
+
+			if hop.isRedirect() && !hop.isPermanent() {
+				return CheckResult{
+					ID:       c.ID(),
+					Title:    c.Title(),
+					Severity: SeverityWarn,
+					Passed:   false,
+					Message:  fmt.Sprintf("%s redirects to %s with a temporary %d", fromHost, canonical, hop.Status),
+					Suggestions: []string{
+						"Use a 301 (or 308) permanent redirect so search engines consolidate ranking on the canonical host",
+					},
+					Details: details,
+				}, nil
+			}
+			if strings.HasPrefix(wwwFinal, "http://") || strings.HasPrefix(hop.Location, "http://") {
+				return CheckResult{
+					ID:       c.ID(),
+					Title:    c.Title(),
+					Severity: SeverityWarn,
+					Passed:   false,
+					Message:  fmt.Sprintf("%s redirects to %s over HTTP", fromHost, canonical),
+					Suggestions: []string{
+						"Redirect to the https:// version of your canonical host",
+					},
+					Details: details,
+				}, nil
+			}
+
 			return CheckResult{
 				ID:       c.ID(),
 				Title:    c.Title(),
 				Severity: SeverityInfo,
 				Passed:   true,
 				Message:  fmt.Sprintf("Both redirect to %s (%s)", canonical, wwwFinalHost),
+				Details:  details,
 			}, nil
 		}
-		// Both work but serve on their respective domains (no redirect)
+
+		// Both serve on their respective domains with no canonical redirect
+		message := "Both www and non-www serve content without a canonical redirect"
+		if wwwHop.Status == http.StatusOK && nonWwwHop.Status == http.StatusOK && bytes.Equal(wwwHop.Body, nonWwwHop.Body) {
+			message = "www and non-www serve identical content without a canonical redirect"
+		}
 		return CheckResult{
 			ID:       c.ID(),
 			Title:    c.Title(),
-			Severity: SeverityInfo,
-			Passed:   true,
-			Message:  "Both www and non-www resolve correctly",
+			Severity: SeverityWarn,
+			Passed:   false,
+			Message:  message,
+			Suggestions: []string{
+				"Pick www or non-www and 301-redirect the other to it",
+				"Duplicate hosts split search ranking between two URLs",
+			},
+			Details: details,
 		}, nil
 	}
 
@@ -163,26 +224,91 @@ func (c WWWRedirectCheck) Run(ctx Context) (CheckResult, error) {
 			"Configure redirects so both point to your canonical URL",
 			fmt.Sprintf("www → %s, non-www → %s", wwwFinalHost, nonWwwFinalHost),
 		},
+		Details: details,
 	}, nil
 }
 
-func getFinalURL(urlStr string) (string, error) {
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-		CheckRedirect: func(req *http.Request, via []*http.Request) error {
-			if len(via) >= 10 {
-				return fmt.Errorf("too many redirects")
-			}
-			return nil
-		},
+// redirectHop is the response to a single request made without following redirects
+type redirectHop struct {
+	Status   int
+	Location string
+	Body     []byte // up to 1 MB, only for 200 responses
+}
+
+func (h redirectHop) isRedirect() bool {
+	return h.Status >= 300 && h.Status < 400 && h.Location != ""
+}
+
+func (h redirectHop) isPermanent() bool {
+	return h.Status == http.StatusMovedPermanently || h.Status == http.StatusPermanentRedirect
+}
+
+// probeNoFollow requests urlStr with a copy of client that doesn't follow
+// redirects, resolving the Location header against the request URL
+func probeNoFollow(client *http.Client, urlStr string) (redirectHop, error) {
+	if client == nil {
+		return redirectHop{}, errOffline
 	}
+	client = noRedirectClient(client)
+
+	req, err := http.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return redirectHop{}, err
+	}
+
+	if err := limiter.Wait(clientContext(client)); err != nil {
+		return redirectHop{}, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return redirectHop{}, err
+	}
+	defer resp.Body.Close()
+
+	hop := redirectHop{Status: resp.StatusCode}
+	if loc, err := resp.Location(); err == nil {
+		hop.Location = loc.String()
+	}
+	if resp.StatusCode == http.StatusOK {
+		hop.Body, _ = io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	}
+	return hop, nil
+}
+
+// describeHop formats a probe result for Details
+func describeHop(label string, hop redirectHop, err error) string {
+	if err != nil {
+		return fmt.Sprintf("%s: %v", label, err)
+	}
+	if hop.isRedirect() {
+		return fmt.Sprintf("%s: %d → %s", label, hop.Status, hop.Location)
+	}
+	return fmt.Sprintf("%s: %d", label, hop.Status)
+}
+
+// getFinalURL follows urlStr's redirects with a copy of client and returns
+// the URL it ends up at
+func getFinalURL(client *http.Client, urlStr string) (string, error) {
+	if client == nil {
+		return "", errOffline
+	}
+	following := *client
+	following.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 10 {
+			return fmt.Errorf("too many redirects")
+		}
+		return nil
+	}
+	client = &following
 
 	req, err := http.NewRequest("GET", urlStr, nil)
 	if err != nil {
 		return "", err
 	}
-	req.Header.Set("User-Agent", "Preflight/1.0")
 
+	if err := limiter.Wait(clientContext(client)); err != nil {
+		return "", err
+	}
 	resp, err := client.Do(req)
 	if err != nil {
 		return "", err
@@ -1,110 +1,221 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
 
 	"gopkg.in/yaml.v3"
 )
 
 type PreflightConfig struct {
-	ProjectName string                   `yaml:"projectName"`
-	Stack       string                   `yaml:"stack"`
-	URLs        URLConfig                `yaml:"urls,omitempty"`
-	Services    map[string]ServiceConfig `yaml:"services,omitempty"`
-	Checks      ChecksConfig             `yaml:"checks,omitempty"`
-	Ignore      []string                 `yaml:"ignore,omitempty"`
+	// Extends names a base config merged under this one (see resolveExtends)
+	Extends     string                   `yaml:"extends,omitempty" json:"extends,omitempty"`
+	ProjectName string                   `yaml:"projectName" json:"projectName"`
+	Stack       string                   `yaml:"stack" json:"stack"`
+	URLs        URLConfig                `yaml:"urls,omitempty" json:"urls,omitempty"`
+	Services    map[string]ServiceConfig `yaml:"services,omitempty" json:"services,omitempty"`
+	Checks      ChecksConfig             `yaml:"checks,omitempty" json:"checks,omitempty"`
+	Ignore      []string                 `yaml:"ignore,omitempty" json:"ignore,omitempty"`
+	Required    []string                 `yaml:"required,omitempty" json:"required,omitempty"`
+	SkipDirs    []string                 `yaml:"skipDirs,omitempty" json:"skipDirs,omitempty"`
+	Paths       []string                 `yaml:"paths,omitempty" json:"paths,omitempty"`
+	HTTP        HTTPConfig               `yaml:"http,omitempty" json:"http,omitempty"`
 }
 
 type URLConfig struct {
-	Staging    string `yaml:"staging,omitempty"`
-	Production string `yaml:"production,omitempty"`
+	Staging    string `yaml:"staging,omitempty" json:"staging,omitempty"`
+	Production string `yaml:"production,omitempty" json:"production,omitempty"`
+	// BasePath is where the app is mounted when it isn't served from the
+	// domain root (e.g. /app behind a reverse proxy)
+	BasePath string `yaml:"basePath,omitempty" json:"basePath,omitempty"`
+}
+
+// HTTPConfig tunes the requests made by checks against live URLs
+type HTTPConfig struct {
+	UserAgent string  `yaml:"userAgent,omitempty" json:"userAgent,omitempty"`
+	Proxy     string  `yaml:"proxy,omitempty" json:"proxy,omitempty"`
+	RateLimit float64 `yaml:"rateLimit,omitempty" json:"rateLimit,omitempty"` // requests per second; 0 uses the default
 }
 
 type ServiceConfig struct {
-	Declared bool `yaml:"declared"`
+	Declared bool `yaml:"declared" json:"declared"`
 }
 
 type ChecksConfig struct {
-	EnvParity      *EnvParityConfig      `yaml:"envParity,omitempty"`
-	HealthEndpoint *HealthEndpointConfig `yaml:"healthEndpoint,omitempty"`
-	StripeWebhook  *StripeWebhookConfig  `yaml:"stripeWebhook,omitempty"`
-	SEOMeta        *SEOMetaConfig        `yaml:"seoMeta,omitempty"`
-	Security       *SecurityConfig       `yaml:"security,omitempty"`
-	Secrets        *SecretsConfig        `yaml:"secrets,omitempty"`
-	AdsTxt         *AdsTxtConfig         `yaml:"adsTxt,omitempty"`
-	License        *LicenseConfig        `yaml:"license,omitempty"`
-	IndexNow       *IndexNowConfig       `yaml:"indexNow,omitempty"`
-	EmailAuth      *EmailAuthConfig      `yaml:"emailAuth,omitempty"`
-	HumansTxt      *HumansTxtConfig      `yaml:"humansTxt,omitempty"`
+	EnvParity       *EnvParityConfig       `yaml:"envParity,omitempty" json:"envParity,omitempty"`
+	HealthEndpoint  *HealthEndpointConfig  `yaml:"healthEndpoint,omitempty" json:"healthEndpoint,omitempty"`
+	StripeWebhook   *StripeWebhookConfig   `yaml:"stripeWebhook,omitempty" json:"stripeWebhook,omitempty"`
+	SEOMeta         *SEOMetaConfig         `yaml:"seoMeta,omitempty" json:"seoMeta,omitempty"`
+	Security        *SecurityConfig        `yaml:"security,omitempty" json:"security,omitempty"`
+	SecurityHeaders *SecurityHeadersConfig `yaml:"securityHeaders,omitempty" json:"securityHeaders,omitempty"`
+	Secrets         *SecretsConfig         `yaml:"secrets,omitempty" json:"secrets,omitempty"`
+	AdsTxt          *AdsTxtConfig          `yaml:"adsTxt,omitempty" json:"adsTxt,omitempty"`
+	License         *LicenseConfig         `yaml:"license,omitempty" json:"license,omitempty"`
+	IndexNow        *IndexNowConfig        `yaml:"indexNow,omitempty" json:"indexNow,omitempty"`
+	EmailAuth       *EmailAuthConfig       `yaml:"emailAuth,omitempty" json:"emailAuth,omitempty"`
+	HumansTxt       *HumansTxtConfig       `yaml:"humansTxt,omitempty" json:"humansTxt,omitempty"`
+	HardcodedURLs   *HardcodedURLsConfig   `yaml:"hardcodedUrls,omitempty" json:"hardcodedUrls,omitempty"`
+	TodoMarkers     *TodoMarkersConfig     `yaml:"todoMarkers,omitempty" json:"todoMarkers,omitempty"`
+	Analytics       *AnalyticsConfig       `yaml:"analytics,omitempty" json:"analytics,omitempty"`
 }
 
 type EnvParityConfig struct {
-	Enabled     bool   `yaml:"enabled"`
-	EnvFile     string `yaml:"envFile"`
-	ExampleFile string `yaml:"exampleFile"`
+	Enabled     bool   `yaml:"enabled" json:"enabled"`
+	EnvFile     string `yaml:"envFile" json:"envFile"`
+	ExampleFile string `yaml:"exampleFile" json:"exampleFile"`
 }
 
 type HealthEndpointConfig struct {
-	Enabled bool   `yaml:"enabled"`
-	Path    string `yaml:"path"`
+	Enabled         bool     `yaml:"enabled" json:"enabled"`
+	Path            string   `yaml:"path" json:"path"`
+	Paths           []string `yaml:"paths,omitempty" json:"paths,omitempty"`                     // all must return 200, e.g. liveness and readiness
+	SkipCommonPaths bool     `yaml:"skipCommonPaths,omitempty" json:"skipCommonPaths,omitempty"` // don't also probe /health, /healthz, ...
 }
 
 type StripeWebhookConfig struct {
-	Enabled bool   `yaml:"enabled"`
-	URL     string `yaml:"url"`
+	Enabled bool   `yaml:"enabled" json:"enabled"`
+	URL     string `yaml:"url" json:"url"`
 }
 
 type SEOMetaConfig struct {
-	Enabled    bool   `yaml:"enabled"`
-	MainLayout string `yaml:"mainLayout"`
+	Enabled      bool                   `yaml:"enabled" json:"enabled"`
+	MainLayout   string                 `yaml:"mainLayout" json:"mainLayout"`
+	Source       string                 `yaml:"source,omitempty" json:"source,omitempty"` // "auto" (default) or "layout"
+	OGImage      *ImageDimensionsConfig `yaml:"ogImage,omitempty" json:"ogImage,omitempty"`
+	TwitterImage *ImageDimensionsConfig `yaml:"twitterImage,omitempty" json:"twitterImage,omitempty"`
+}
+
+// ImageDimensionsConfig overrides the social image size thresholds. Zero
+// values keep the defaults.
+type ImageDimensionsConfig struct {
+	MinWidth          int `yaml:"minWidth,omitempty" json:"minWidth,omitempty"`
+	MinHeight         int `yaml:"minHeight,omitempty" json:"minHeight,omitempty"`
+	RecommendedWidth  int `yaml:"recommendedWidth,omitempty" json:"recommendedWidth,omitempty"`
+	RecommendedHeight int `yaml:"recommendedHeight,omitempty" json:"recommendedHeight,omitempty"`
 }
 
 type SecurityConfig struct {
-	Enabled bool `yaml:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled"`
 }
 
+// SecurityHeadersConfig customizes the headers the securityHeaders check expects.
+// Required replaces the default set; headers listed under optional are only
+// reported, never failed.
+type SecurityHeadersConfig struct {
+	Required []string `yaml:"required,omitempty" json:"required,omitempty"`
+	Optional []string `yaml:"optional,omitempty" json:"optional,omitempty"`
+}
+
+// SecretsConfig enables the secret scan. Allow lists path globs (gitignore
+// style, e.g. test/fixtures/ or **/*.example.json) whose files aren't scanned.
 type SecretsConfig struct {
-	Enabled bool `yaml:"enabled"`
+	Enabled bool     `yaml:"enabled" json:"enabled"`
+	Allow   []string `yaml:"allow,omitempty" json:"allow,omitempty"`
 }
 
 type AdsTxtConfig struct {
-	Enabled bool `yaml:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled"`
 }
 
 type LicenseConfig struct {
-	Enabled bool `yaml:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled"`
 }
 
 type IndexNowConfig struct {
-	Enabled bool   `yaml:"enabled"`
-	Key     string `yaml:"key"`
+	Enabled bool   `yaml:"enabled" json:"enabled"`
+	Key     string `yaml:"key" json:"key"`
 }
 
 type EmailAuthConfig struct {
-	Enabled bool `yaml:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled"`
 }
 
 type HumansTxtConfig struct {
-	Enabled bool `yaml:"enabled"`
+	Enabled bool `yaml:"enabled" json:"enabled"`
+}
+
+// HardcodedURLsConfig lists localhost/staging references that are acceptable
+// in source, matched as substrings of the reported URL or file path
+type HardcodedURLsConfig struct {
+	Allow []string `yaml:"allow" json:"allow"`
 }
 
-// Load reads and parses the preflight.yml config file
+// TodoMarkersConfig sets when TODO/FIXME/XXX/HACK comments fail the check.
+// Markers tagged with a blocking label, like FIXME(launch), always do.
+// Zero values use the check's defaults.
+type TodoMarkersConfig struct {
+	Threshold int      `yaml:"threshold" json:"threshold"`
+	Blocking  []string `yaml:"blocking" json:"blocking"`
+}
+
+// AnalyticsConfig sets how the analytics check reports a site with no
+// analytics provider: "info" (default) or "warn"
+type AnalyticsConfig struct {
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty"`
+}
+
+// ConfigFileNames lists the supported config files in order of precedence
+var ConfigFileNames = []string{"preflight.yml", "preflight.yaml", "preflight.json"}
+
+// Load reads and parses the config file in rootDir, preferring
+// preflight.yml, then preflight.yaml, then preflight.json
 func Load(rootDir string) (*PreflightConfig, error) {
-	configPath := filepath.Join(rootDir, "preflight.yml")
+	configPath := findInDir(rootDir)
+	if configPath == "" {
+		return nil, fmt.Errorf("preflight.yml not found in %s", rootDir)
+	}
+
+	return LoadFile(configPath)
+}
+
+// LoadFile reads and parses the config file at an explicit path. Keys that
+// don't match any setting are an error, since a typo would otherwise leave
+// the setting silently unset.
+func LoadFile(configPath string) (*PreflightConfig, error) {
+	return loadFile(configPath, true)
+}
+
+// LoadFileLenient is LoadFile without the unknown key check, for configs
+// written for a newer preflight
+func LoadFileLenient(configPath string) (*PreflightConfig, error) {
+	return loadFile(configPath, false)
+}
 
-	data, err := os.ReadFile(configPath)
+func loadFile(configPath string, strict bool) (*PreflightConfig, error) {
+	doc, err := readDocument(configPath)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("preflight.yml not found in %s", rootDir)
+		return nil, err
+	}
+
+	if strict && len(doc.Content) > 0 {
+		var unknown []UnknownKey
+		findUnknownKeys(doc.Content[0], reflect.TypeOf(PreflightConfig{}), "", &unknown)
+		if len(unknown) > 0 {
+			return nil, unknownKeysError(configName(configPath), unknown)
 		}
-		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	// Shared settings from a base config, with this file's keys on top
+	if doc, err = resolveExtends(configPath, doc, strict, nil); err != nil {
+		return nil, err
+	}
+
+	// Resolve ${VAR} references against the environment before decoding
+	if err := interpolateEnv(doc); err != nil {
+		return nil, err
 	}
 
 	var cfg PreflightConfig
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("failed to parse preflight.yml: %w", err)
+	if len(doc.Content) > 0 {
+		if err := doc.Decode(&cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", configName(configPath), err)
+		}
 	}
 
 	// Apply defaults
@@ -113,6 +224,109 @@ func Load(rootDir string) (*PreflightConfig, error) {
 	return &cfg, nil
 }
 
+// StdinPath is the config path that reads the config from standard input,
+// for CI jobs that generate it on the fly
+const StdinPath = "-"
+
+var (
+	stdinOnce sync.Once
+	stdinData []byte
+	stdinErr  error
+)
+
+// readStdin reads standard input once, so the config can be parsed again
+// (e.g. by doctor, which checks keys before loading)
+func readStdin() ([]byte, error) {
+	stdinOnce.Do(func() {
+		stdinData, stdinErr = io.ReadAll(os.Stdin)
+	})
+	return stdinData, stdinErr
+}
+
+// configName names the config in error messages
+func configName(configPath string) string {
+	if configPath == StdinPath {
+		return "config from stdin"
+	}
+	return filepath.Base(configPath)
+}
+
+// readDocument parses a YAML or JSON config file into a YAML node tree.
+// StdinPath reads standard input; JSON needs no conversion there, since
+// YAML parses it as is.
+func readDocument(configPath string) (*yaml.Node, error) {
+	var data []byte
+	var err error
+	if configPath == StdinPath {
+		data, err = readStdin()
+	} else {
+		data, err = os.ReadFile(configPath)
+	}
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("config file not found: %s", configPath)
+		}
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	// JSON is re-encoded as YAML so both formats share interpolation and decoding
+	if strings.EqualFold(filepath.Ext(configPath), ".json") {
+		var raw interface{}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", configName(configPath), err)
+		}
+		if data, err = yaml.Marshal(raw); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", configName(configPath), err)
+		}
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", configName(configPath), err)
+	}
+	return &doc, nil
+}
+
+// Find walks up from startDir looking for a config file and returns its path.
+// The search stops at the first directory containing .git (the repository
+// root) or at the filesystem root.
+func Find(startDir string) (string, error) {
+	dir, err := filepath.Abs(startDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", startDir, err)
+	}
+
+	for {
+		if candidate := findInDir(dir); candidate != "" {
+			return candidate, nil
+		}
+
+		// Don't escape the current repository
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			break
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+
+	return "", fmt.Errorf("preflight.yml not found in %s or any parent directory", startDir)
+}
+
+// findInDir returns the highest-precedence config file in dir, or ""
+func findInDir(dir string) string {
+	for _, name := range ConfigFileNames {
+		candidate := filepath.Join(dir, name)
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate
+		}
+	}
+	return ""
+}
+
 func applyDefaults(cfg *PreflightConfig) {
 	if cfg.Stack == "" {
 		cfg.Stack = "unknown"
@@ -128,7 +342,7 @@ func applyDefaults(cfg *PreflightConfig) {
 	}
 
 	if cfg.Checks.HealthEndpoint != nil {
-		if cfg.Checks.HealthEndpoint.Path == "" {
+		if cfg.Checks.HealthEndpoint.Path == "" && len(cfg.Checks.HealthEndpoint.Paths) == 0 {
 			cfg.Checks.HealthEndpoint.Path = "/health"
 		}
 	}
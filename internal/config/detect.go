@@ -12,215 +12,6 @@ import (
 	"time"
 )
 
-// DetectStack determines the project stack based on files present
-func DetectStack(rootDir string) string {
-	// Check for Rails
-	if fileExists(rootDir, "Gemfile") && fileExists(rootDir, "config/routes.rb") {
-		return "rails"
-	}
-
-	// Check for Next.js (including monorepo structures)
-	if fileExists(rootDir, "next.config.js") || fileExists(rootDir, "next.config.mjs") || fileExists(rootDir, "next.config.ts") {
-		return "next"
-	}
-	// Check monorepo structures for Next.js
-	if hasMonorepoFramework(rootDir, []string{"next.config.js", "next.config.mjs", "next.config.ts"}) {
-		return "next"
-	}
-
-	// Check for Laravel
-	if fileExists(rootDir, "artisan") && fileExists(rootDir, "composer.json") {
-		return "laravel"
-	}
-
-	// === Traditional CMS ===
-
-	// Check for WordPress
-	if fileExists(rootDir, "wp-config.php") || fileExists(rootDir, "wp-content/themes") {
-		return "wordpress"
-	}
-
-	// Check for Craft CMS
-	if fileExists(rootDir, "craft") || fileContains(rootDir, "composer.json", "craftcms/cms") {
-		return "craft"
-	}
-
-	// Check for Drupal
-	if fileExists(rootDir, "core/lib/Drupal.php") || (fileExists(rootDir, "sites/default") && fileExists(rootDir, "core")) {
-		return "drupal"
-	}
-
-	// Check for Ghost (before generic Node.js check)
-	if fileContains(rootDir, "package.json", "\"ghost\"") || fileExists(rootDir, "content/themes") {
-		return "ghost"
-	}
-
-	// === Static Site Generators ===
-
-	// Check for Hugo
-	if fileExists(rootDir, "hugo.toml") || fileExists(rootDir, "hugo.yaml") || fileExists(rootDir, "hugo.json") ||
-		(fileExists(rootDir, "config.toml") && fileExists(rootDir, "content") && fileExists(rootDir, "themes")) {
-		return "hugo"
-	}
-
-	// Check for Jekyll
-	if fileExists(rootDir, "_config.yml") && (fileExists(rootDir, "_posts") || fileExists(rootDir, "_layouts")) {
-		return "jekyll"
-	}
-
-	// Check for Gatsby
-	if fileExists(rootDir, "gatsby-config.js") || fileExists(rootDir, "gatsby-config.ts") || fileExists(rootDir, "gatsby-config.mjs") {
-		return "gatsby"
-	}
-
-	// Check for Eleventy (11ty)
-	if fileExists(rootDir, ".eleventy.js") || fileExists(rootDir, "eleventy.config.js") || fileExists(rootDir, "eleventy.config.mjs") ||
-		fileContains(rootDir, "package.json", "@11ty/eleventy") {
-		return "eleventy"
-	}
-
-	// Check for Astro
-	if fileExists(rootDir, "astro.config.mjs") || fileExists(rootDir, "astro.config.ts") || fileExists(rootDir, "astro.config.js") {
-		return "astro"
-	}
-
-	// === Headless CMS ===
-
-	// Check for Strapi
-	if fileContains(rootDir, "package.json", "@strapi/strapi") || fileExists(rootDir, "src/api") && fileExists(rootDir, "config/database.js") {
-		return "strapi"
-	}
-
-	// Check for Sanity
-	if fileExists(rootDir, "sanity.json") || fileExists(rootDir, "sanity.config.ts") || fileExists(rootDir, "sanity.config.js") ||
-		fileContains(rootDir, "package.json", "sanity") {
-		return "sanity"
-	}
-
-	// Check for Contentful (usually detected via env vars, but check for config)
-	if fileContains(rootDir, "package.json", "contentful") {
-		return "contentful"
-	}
-
-	// Check for Prismic
-	if fileExists(rootDir, "prismicio.js") || fileExists(rootDir, "slicemachine.config.json") ||
-		fileContains(rootDir, "package.json", "@prismicio") {
-		return "prismic"
-	}
-
-	// === General Stacks ===
-
-	// Check for Go
-	if fileExists(rootDir, "go.mod") {
-		return "go"
-	}
-
-	// Check for Python (Django/Flask)
-	if fileExists(rootDir, "requirements.txt") || fileExists(rootDir, "pyproject.toml") || fileExists(rootDir, "Pipfile") {
-		if fileExists(rootDir, "manage.py") {
-			return "django"
-		}
-		return "python"
-	}
-
-	// Check for Rust
-	if fileExists(rootDir, "Cargo.toml") {
-		return "rust"
-	}
-
-	// Check for basic PHP site (before Node.js, since PHP sites often use Node for build tools)
-	if fileExists(rootDir, "public/index.php") || fileExists(rootDir, "index.php") || fileExists(rootDir, "web/index.php") {
-		// Not a known PHP framework, just a plain PHP site
-		return "php"
-	}
-
-	// Check for Node.js frameworks
-	if fileExists(rootDir, "package.json") {
-		// Check for Vite
-		if fileExists(rootDir, "vite.config.js") || fileExists(rootDir, "vite.config.ts") || fileExists(rootDir, "vite.config.mjs") {
-			return "vite"
-		}
-
-		// Check for specific frameworks in package.json
-		if pkgJSON, err := os.ReadFile(filepath.Join(rootDir, "package.json")); err == nil {
-			content := string(pkgJSON)
-			// Check for React
-			if strings.Contains(content, "\"react\"") {
-				return "react"
-			}
-			// Check for Vue
-			if strings.Contains(content, "\"vue\"") {
-				return "vue"
-			}
-			// Check for Svelte
-			if strings.Contains(content, "\"svelte\"") {
-				return "svelte"
-			}
-			// Check for Angular
-			if strings.Contains(content, "\"@angular/core\"") {
-				return "angular"
-			}
-
-			// Check if it's a static site with build tools (e.g., Tailwind)
-			if hasHTMLFiles(rootDir) && isStaticSiteWithBuildTools(content) {
-				return "static"
-			}
-
-			// Only return "node" if there are actual Node.js app indicators
-			if isNodeApp(rootDir, content) {
-				return "node"
-			}
-		}
-
-		// If package.json exists but no Node.js app indicators, check for static site
-		if hasHTMLFiles(rootDir) {
-			return "static"
-		}
-
-		return "node"
-	}
-
-	// Check for static site
-	if fileExists(rootDir, "index.html") {
-		return "static"
-	}
-
-	return "unknown"
-}
-
-// fileContains checks if a file exists and contains a specific string
-func fileContains(rootDir, relativePath, search string) bool {
-	path := filepath.Join(rootDir, relativePath)
-	content, err := os.ReadFile(path)
-	if err != nil {
-		return false
-	}
-	return strings.Contains(string(content), search)
-}
-
-// hasMonorepoFramework checks if any monorepo subdirectory contains the specified files
-func hasMonorepoFramework(rootDir string, files []string) bool {
-	monorepoRoots := []string{"apps", "packages", "services"}
-	for _, monoRoot := range monorepoRoots {
-		monoDir := filepath.Join(rootDir, monoRoot)
-		entries, err := os.ReadDir(monoDir)
-		if err != nil {
-			continue
-		}
-		for _, entry := range entries {
-			if !entry.IsDir() {
-				continue
-			}
-			for _, file := range files {
-				if fileExists(filepath.Join(monoDir, entry.Name()), file) {
-					return true
-				}
-			}
-		}
-	}
-	return false
-}
-
 // AllServices returns the list of all supported services
 var AllServices = []string{
 	// Payments
@@ -267,6 +58,11 @@ var AllServices = []string{
 	"segment",
 	"hotjar",
 
+	// Ad Pixels
+	"meta_pixel",
+	"tiktok_pixel",
+	"linkedin_insight",
+
 	// Auth
 	"auth0",
 	"clerk",
@@ -518,6 +314,15 @@ func detectServicesFromContent(content string, services map[string]bool, lang st
 	if strings.Contains(content, "hotjar") {
 		services["hotjar"] = true
 	}
+	if strings.Contains(content, "react-facebook-pixel") || strings.Contains(content, "@meta/pixel") {
+		services["meta_pixel"] = true
+	}
+	if strings.Contains(content, "tiktok-pixel") {
+		services["tiktok_pixel"] = true
+	}
+	if strings.Contains(content, "react-linkedin-insight") {
+		services["linkedin_insight"] = true
+	}
 	if strings.Contains(content, "react-ga") || strings.Contains(content, "vue-gtag") {
 		services["google_analytics"] = true
 	}
@@ -713,6 +518,11 @@ func detectServicesFromEnv(rootDir string, services map[string]bool) map[string]
 		"segment":          {"SEGMENT_"},
 		"hotjar":           {"HOTJAR_"},
 
+		// Ad Pixels
+		"meta_pixel":       {"META_PIXEL", "FACEBOOK_PIXEL", "FB_PIXEL", "NEXT_PUBLIC_META_PIXEL", "NEXT_PUBLIC_FB_PIXEL"},
+		"tiktok_pixel":     {"TIKTOK_PIXEL", "NEXT_PUBLIC_TIKTOK_PIXEL"},
+		"linkedin_insight": {"LINKEDIN_PARTNER_ID", "LINKEDIN_INSIGHT", "NEXT_PUBLIC_LINKEDIN_PARTNER_ID"},
+
 		// Auth
 		"auth0":    {"AUTH0_"},
 		"clerk":    {"CLERK_", "NEXT_PUBLIC_CLERK"},
@@ -828,6 +638,11 @@ func detectAnalyticsScripts(rootDir string, services map[string]bool) {
 		"segment":          regexp.MustCompile(`(?i)cdn\.segment\.com|analytics\.load\(`),
 		"amplitude":        regexp.MustCompile(`(?i)cdn\.amplitude\.com|amplitude\.getInstance`),
 
+		// Ad pixels
+		"meta_pixel":       regexp.MustCompile(`connect\.facebook\.net/[a-zA-Z_]+/fbevents\.js|\bfbq\(\s*['"]init['"]`),
+		"tiktok_pixel":     regexp.MustCompile(`analytics\.tiktok\.com/i18n/pixel|\bttq\.load\(`),
+		"linkedin_insight": regexp.MustCompile(`snap\.licdn\.com/li\.lms-analytics|_linkedin_partner_id`),
+
 		// Communication - require specific URLs or SDK
 		"intercom": regexp.MustCompile(`(?i)widget\.intercom\.io|Intercom\(['"]|intercom-client`),
 		"crisp":    regexp.MustCompile(`(?i)client\.crisp\.chat|CRISP_WEBSITE_ID`),
@@ -1039,6 +854,9 @@ func detectAnalyticsScripts(rootDir string, services map[string]bool) {
 			"hotjar":           patterns["hotjar"],
 			"mixpanel":         patterns["mixpanel"],
 			"segment":          patterns["segment"],
+			"meta_pixel":       patterns["meta_pixel"],
+			"tiktok_pixel":     patterns["tiktok_pixel"],
+			"linkedin_insight": patterns["linkedin_insight"],
 			"intercom":         patterns["intercom"],
 			"crisp":            patterns["crisp"],
 		}
@@ -1124,252 +942,6 @@ func fileExists(rootDir, relativePath string) bool {
 	return err == nil
 }
 
-// hasHTMLFiles checks if the project root or common web directories contain HTML files
-func hasHTMLFiles(rootDir string) bool {
-	// Check root directory
-	if fileExists(rootDir, "index.html") {
-		return true
-	}
-	// Check common static site directories
-	staticDirs := []string{"public", "dist", "build", "www", "static", "_site", "out"}
-	for _, dir := range staticDirs {
-		if fileExists(rootDir, filepath.Join(dir, "index.html")) {
-			return true
-		}
-	}
-	return false
-}
-
-// isStaticSiteWithBuildTools checks if package.json only contains build/dev tools
-// like Tailwind, PostCSS, etc. without any runtime Node.js dependencies
-func isStaticSiteWithBuildTools(packageJSON string) bool {
-	// Build tools that don't indicate a Node.js app
-	buildTools := []string{
-		"tailwindcss",
-		"postcss",
-		"autoprefixer",
-		"sass",
-		"less",
-		"stylus",
-		"cssnano",
-		"purgecss",
-		"@tailwindcss/",
-		"prettier",
-		"eslint",
-		"stylelint",
-		"webpack",
-		"parcel",
-		"rollup",
-		"esbuild",
-		"terser",
-		"uglify",
-		"babel",
-		"typescript",
-		"live-server",
-		"browser-sync",
-		"http-server",
-		"serve",
-		"concurrently",
-		"npm-run-all",
-		"cross-env",
-		"dotenv",
-		"husky",
-		"lint-staged",
-	}
-
-	// Node.js app indicators that would disqualify as static
-	appIndicators := []string{
-		"express",
-		"koa",
-		"fastify",
-		"hapi",
-		"@hapi/hapi",
-		"nest",
-		"@nestjs/",
-		"restify",
-		"polka",
-		"micro",
-		"moleculer",
-		"feathers",
-		"@feathersjs/",
-		"loopback",
-		"adonis",
-		"@adonisjs/",
-		"sails",
-		"meteor",
-		"socket.io",
-		"ws\"",
-		"graphql",
-		"apollo-server",
-		"prisma",
-		"sequelize",
-		"typeorm",
-		"mongoose",
-		"mongodb",
-		"pg\"",
-		"mysql",
-		"redis\"",
-		"ioredis",
-		"bull",
-		"agenda",
-		"node-cron",
-		"puppeteer",
-		"playwright",
-		"electron",
-		"\"react\"",
-		"\"vue\"",
-		"\"svelte\"",
-		"\"@angular/core\"",
-		"\"next\"",
-		"nuxt",
-		"gatsby",
-		"remix",
-		"@remix-run",
-		"solid-js",
-		"qwik",
-		"preact",
-	}
-
-	content := strings.ToLower(packageJSON)
-
-	// If any app indicators are present, it's not a static site with build tools
-	for _, indicator := range appIndicators {
-		if strings.Contains(content, strings.ToLower(indicator)) {
-			return false
-		}
-	}
-
-	// Check if at least one build tool is present
-	for _, tool := range buildTools {
-		if strings.Contains(content, strings.ToLower(tool)) {
-			return true
-		}
-	}
-
-	return false
-}
-
-// isNodeApp checks for indicators that this is actually a Node.js application
-func isNodeApp(rootDir string, packageJSON string) bool {
-	// Check for common Node.js entry point files
-	entryPoints := []string{
-		"server.js",
-		"server.ts",
-		"app.js",
-		"app.ts",
-		"index.js",
-		"index.ts",
-		"main.js",
-		"main.ts",
-		"src/index.js",
-		"src/index.ts",
-		"src/server.js",
-		"src/server.ts",
-		"src/app.js",
-		"src/app.ts",
-	}
-
-	for _, entry := range entryPoints {
-		if fileExists(rootDir, entry) {
-			// index.js/ts alone isn't enough - could be a build tool config
-			// Check if it looks like an app entry point
-			if entry == "index.js" || entry == "index.ts" {
-				content, err := os.ReadFile(filepath.Join(rootDir, entry))
-				if err == nil {
-					contentStr := string(content)
-					// Look for server/app patterns
-					if strings.Contains(contentStr, "listen(") ||
-						strings.Contains(contentStr, "createServer") ||
-						strings.Contains(contentStr, "express()") ||
-						strings.Contains(contentStr, "new Koa") ||
-						strings.Contains(contentStr, "fastify(") {
-						return true
-					}
-				}
-				continue
-			}
-			return true
-		}
-	}
-
-	content := strings.ToLower(packageJSON)
-
-	// Check for Node.js server frameworks in dependencies
-	serverFrameworks := []string{
-		"\"express\"",
-		"\"koa\"",
-		"\"fastify\"",
-		"\"hapi\"",
-		"\"@hapi/hapi\"",
-		"\"@nestjs/core\"",
-		"\"restify\"",
-		"\"polka\"",
-		"\"micro\"",
-		"\"moleculer\"",
-		"\"@feathersjs/feathers\"",
-		"\"loopback\"",
-		"\"@adonisjs/core\"",
-		"\"sails\"",
-		"\"socket.io\"",
-	}
-
-	for _, framework := range serverFrameworks {
-		if strings.Contains(content, framework) {
-			return true
-		}
-	}
-
-	// Check for "start" script that runs node
-	// Look for patterns like "node ", "ts-node", "nodemon"
-	startPatterns := []string{
-		"\"start\":",
-		"\"dev\":",
-		"\"serve\":",
-	}
-
-	nodeRunPatterns := []string{
-		"node ",
-		"ts-node",
-		"nodemon",
-		"tsx ",
-		"npx ts-node",
-	}
-
-	for _, startPat := range startPatterns {
-		if idx := strings.Index(content, startPat); idx != -1 {
-			// Get the script value (next ~100 chars should be enough)
-			end := idx + 150
-			if end > len(content) {
-				end = len(content)
-			}
-			scriptSection := content[idx:end]
-			for _, runPat := range nodeRunPatterns {
-				if strings.Contains(scriptSection, runPat) {
-					return true
-				}
-			}
-		}
-	}
-
-	// Check for "main" field pointing to a JS file (not just types)
-	if strings.Contains(content, "\"main\":") {
-		// Look for .js extension in main field
-		mainIdx := strings.Index(content, "\"main\":")
-		if mainIdx != -1 {
-			end := mainIdx + 80
-			if end > len(content) {
-				end = len(content)
-			}
-			mainSection := content[mainIdx:end]
-			if strings.Contains(mainSection, ".js\"") && !strings.Contains(mainSection, ".d.ts") {
-				return true
-			}
-		}
-	}
-
-	return false
-}
-
 // detectIndexNowKeyFile checks for IndexNow key files (32-char hex .txt files) in web roots
 func detectIndexNowKeyFile(rootDir string, services map[string]bool) {
 	webRoots := []string{"public", "web", "static", "_site", "dist", ""}
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// additiveKeys are top-level lists that a config adds to its base's instead
+// of replacing them, so an app can ignore or require one more check without
+// repeating the shared list
+var additiveKeys = map[string]bool{
+	"ignore":   true,
+	"required": true,
+	"skipDirs": true,
+}
+
+// resolveExtends loads the config named by doc's extends key, following its
+// own extends first, and merges doc over it. The path is relative to the
+// directory of configPath (the working directory for stdin). strict checks
+// each base file for unknown keys, as loadFile does for the config itself.
+func resolveExtends(configPath string, doc *yaml.Node, strict bool, chain []string) (*yaml.Node, error) {
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return doc, nil
+	}
+	root := doc.Content[0]
+	extends := mappingValue(root, "extends")
+	if extends == nil {
+		return doc, nil
+	}
+	if extends.Kind != yaml.ScalarNode || strings.TrimSpace(extends.Value) == "" {
+		return nil, fmt.Errorf("%s: extends must be a file path", configName(configPath))
+	}
+
+	basePath := strings.TrimSpace(extends.Value)
+	if !filepath.IsAbs(basePath) {
+		dir := "."
+		if configPath != StdinPath {
+			dir = filepath.Dir(configPath)
+		}
+		basePath = filepath.Join(dir, basePath)
+	}
+	if abs, err := filepath.Abs(basePath); err == nil {
+		basePath = abs
+	}
+
+	if configPath != StdinPath && len(chain) == 0 {
+		if abs, err := filepath.Abs(configPath); err == nil {
+			chain = []string{abs}
+		}
+	}
+	for _, seen := range chain {
+		if seen == basePath {
+			return nil, fmt.Errorf("extends cycle: %s -> %s", strings.Join(chain, " -> "), basePath)
+		}
+	}
+
+	base, err := readDocument(basePath)
+	if err != nil {
+		return nil, fmt.Errorf("%s extends %s: %w", configName(configPath), extends.Value, err)
+	}
+	if strict && len(base.Content) > 0 {
+		var unknown []UnknownKey
+		findUnknownKeys(base.Content[0], reflect.TypeOf(PreflightConfig{}), "", &unknown)
+		if len(unknown) > 0 {
+			return nil, unknownKeysError(basePath, unknown)
+		}
+	}
+	base, err = resolveExtends(basePath, base, strict, append(chain, basePath))
+	if err != nil {
+		return nil, err
+	}
+	if len(base.Content) == 0 || base.Content[0].Kind != yaml.MappingNode {
+		return doc, nil
+	}
+
+	doc.Content[0] = mergeNodes(base.Content[0], root, true)
+	return doc, nil
+}
+
+// mergeNodes merges the local mapping over base: mappings merge key by key,
+// the additive top-level lists are concatenated (without repeats), and any
+// other local value replaces the base one
+func mergeNodes(base, local *yaml.Node, topLevel bool) *yaml.Node {
+	base, local = deref(base), deref(local)
+	if base.Kind != yaml.MappingNode || local.Kind != yaml.MappingNode {
+		return local
+	}
+
+	merged := *base
+	merged.Content = append([]*yaml.Node(nil), base.Content...)
+	for i := 0; i+1 < len(local.Content); i += 2 {
+		key, value := local.Content[i], local.Content[i+1]
+		j := mappingIndex(&merged, key.Value)
+		if j < 0 {
+			merged.Content = append(merged.Content, key, value)
+			continue
+		}
+		baseValue := deref(merged.Content[j+1])
+		switch {
+		case baseValue.Kind == yaml.MappingNode && deref(value).Kind == yaml.MappingNode:
+			merged.Content[j+1] = mergeNodes(baseValue, value, false)
+		case topLevel && additiveKeys[key.Value] && baseValue.Kind == yaml.SequenceNode && deref(value).Kind == yaml.SequenceNode:
+			merged.Content[j+1] = concatSequences(baseValue, deref(value))
+		default:
+			merged.Content[j+1] = value
+		}
+	}
+	return &merged
+}
+
+// concatSequences appends the items of b to a, skipping scalars a already has
+func concatSequences(a, b *yaml.Node) *yaml.Node {
+	seq := *a
+	seq.Content = append([]*yaml.Node(nil), a.Content...)
+	have := make(map[string]bool)
+	for _, item := range seq.Content {
+		if item.Kind == yaml.ScalarNode {
+			have[item.Value] = true
+		}
+	}
+	for _, item := range b.Content {
+		if item.Kind == yaml.ScalarNode {
+			if have[item.Value] {
+				continue
+			}
+			have[item.Value] = true
+		}
+		seq.Content = append(seq.Content, item)
+	}
+	return &seq
+}
+
+// mappingIndex returns the index of key in a mapping node's content, or -1
+func mappingIndex(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// mappingValue returns the value of key in a mapping node, or nil
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if i := mappingIndex(node, key); i >= 0 {
+		return node.Content[i+1]
+	}
+	return nil
+}
+
+// deref follows a YAML alias (*name) to the node it refers to
+func deref(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// envVarPattern matches ${VAR} and ${VAR:-default}
+var envVarPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// interpolateEnv replaces ${VAR} and ${VAR:-default} references in every
+// scalar value of the document with values from the process environment.
+// An unset variable without a default is reported with the key it appears in.
+func interpolateEnv(node *yaml.Node) error {
+	var missing []string
+	walkScalars(node, "", func(n *yaml.Node, path string) {
+		if !strings.Contains(n.Value, "${") {
+			return
+		}
+		n.Value = envVarPattern.ReplaceAllStringFunc(n.Value, func(ref string) string {
+			m := envVarPattern.FindStringSubmatch(ref)
+			name, hasDefault, def := m[1], m[2] != "", m[3]
+			if val, ok := os.LookupEnv(name); ok && (val != "" || !hasDefault) {
+				return val
+			}
+			if hasDefault {
+				return def
+			}
+			missing = append(missing, fmt.Sprintf("%s (${%s})", path, name))
+			return ref
+		})
+		// Let plain scalars re-resolve so "${FLAG}" can decode as a bool or number
+		if n.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			n.Tag = ""
+		}
+	})
+
+	if len(missing) > 0 {
+		return fmt.Errorf("unset environment variable referenced in config: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// walkScalars calls fn for each scalar value node, passing its dotted key path
+func walkScalars(node *yaml.Node, path string, fn func(n *yaml.Node, path string)) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, child := range node.Content {
+			walkScalars(child, path, fn)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			if path != "" {
+				key = path + "." + key
+			}
+			walkScalars(node.Content[i+1], key, fn)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			walkScalars(child, fmt.Sprintf("%s[%d]", path, i), fn)
+		}
+	case yaml.ScalarNode:
+		fn(node, path)
+	}
+}
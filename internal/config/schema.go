@@ -0,0 +1,65 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+)
+
+// SchemaURL identifies the JSON Schema draft that Schema produces
+const SchemaURL = "https://json-schema.org/draft-07/schema#"
+
+// Schema returns a JSON Schema for preflight.yml, built from the yaml tags
+// on PreflightConfig so it always matches what the loader accepts. Unknown
+// keys are rejected, as in the default strict mode.
+func Schema() map[string]interface{} {
+	schema := typeSchema(reflect.TypeOf(PreflightConfig{}))
+	schema["$schema"] = SchemaURL
+	schema["title"] = "Preflight configuration"
+	return schema
+}
+
+func typeSchema(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		properties := make(map[string]interface{})
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+			if name == "" || name == "-" || !field.IsExported() {
+				continue
+			}
+			properties[name] = typeSchema(field.Type)
+		}
+		return map[string]interface{}{
+			"type":                 "object",
+			"properties":           properties,
+			"additionalProperties": false,
+		}
+	case reflect.Map:
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": typeSchema(t.Elem()),
+		}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{
+			"type":  "array",
+			"items": typeSchema(t.Elem()),
+		}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	default:
+		// interface{} and anything else accepts any value
+		return map[string]interface{}{}
+	}
+}
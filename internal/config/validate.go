@@ -0,0 +1,150 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnknownKey is a config key that doesn't map to any PreflightConfig field
+type UnknownKey struct {
+	Path       string // dotted key path, e.g. urls.prodcution
+	Line       int
+	Suggestion string // closest valid key at the same level, if any
+}
+
+// UnknownKeys reports keys in the config file that preflight doesn't recognize
+func UnknownKeys(configPath string) ([]UnknownKey, error) {
+	doc, err := readDocument(configPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	var unknown []UnknownKey
+	findUnknownKeys(doc.Content[0], reflect.TypeOf(PreflightConfig{}), "", &unknown)
+	return unknown, nil
+}
+
+// unknownKeysError lists every unknown key with its line and suggestion
+func unknownKeysError(fileName string, unknown []UnknownKey) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "unknown key(s) in %s:", fileName)
+	for _, key := range unknown {
+		fmt.Fprintf(&b, "\n  line %d: %s", key.Line, key.Path)
+		if key.Suggestion != "" {
+			fmt.Fprintf(&b, " (did you mean %q?)", key.Suggestion)
+		}
+	}
+	b.WriteString("\nFix the key(s) or run with --lenient to ignore them")
+	return errors.New(b.String())
+}
+
+func findUnknownKeys(node *yaml.Node, t reflect.Type, path string, unknown *[]UnknownKey) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+		fields := make(map[string]reflect.Type)
+		var names []string
+		for i := 0; i < t.NumField(); i++ {
+			name := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+			fields[name] = t.Field(i).Type
+			names = append(names, name)
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			fieldType, ok := fields[key.Value]
+			if !ok {
+				*unknown = append(*unknown, UnknownKey{
+					Path:       joinKeyPath(path, key.Value),
+					Line:       key.Line,
+					Suggestion: Suggest(key.Value, names),
+				})
+				continue
+			}
+			findUnknownKeys(value, fieldType, joinKeyPath(path, key.Value), unknown)
+		}
+
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			findUnknownKeys(node.Content[i+1], t.Elem(), joinKeyPath(path, node.Content[i].Value), unknown)
+		}
+
+	case reflect.Slice:
+		if node.Kind != yaml.SequenceNode {
+			return
+		}
+		for _, item := range node.Content {
+			findUnknownKeys(item, t.Elem(), path, unknown)
+		}
+	}
+}
+
+func joinKeyPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// Suggest returns the candidate closest to name by edit distance, or "" when
+// nothing is close enough to be a likely typo
+func Suggest(name string, candidates []string) string {
+	best := ""
+	bestDistance := -1
+	for _, candidate := range candidates {
+		d := levenshtein(strings.ToLower(name), strings.ToLower(candidate))
+		if bestDistance < 0 || d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+
+	// Allow roughly one edit per three characters, and at least two
+	maxDistance := len(name) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	if bestDistance < 0 || bestDistance > maxDistance {
+		return ""
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
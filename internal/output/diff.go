@@ -0,0 +1,140 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// DiffEntry is a check whose outcome changed between two reports. A
+// severity is empty when the check passed, was skipped, or didn't run.
+type DiffEntry struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	OldSeverity string `json:"oldSeverity,omitempty"`
+	NewSeverity string `json:"newSeverity,omitempty"`
+	Message     string `json:"message,omitempty"`
+}
+
+// Diff lists what changed between an older and a newer --format json report
+type Diff struct {
+	NewFailures     []DiffEntry `json:"newFailures"`
+	Fixed           []DiffEntry `json:"fixed"`
+	SeverityChanged []DiffEntry `json:"severityChanged"`
+}
+
+// ReadJSONOutput loads a report saved with --format json
+func ReadJSONOutput(path string) (JSONOutput, error) {
+	var report JSONOutput
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return report, err
+	}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return report, fmt.Errorf("%s is not a preflight JSON report: %w", path, err)
+	}
+	return report, nil
+}
+
+// failing reports the severity of a failed result, or "" if it didn't fail.
+// A check that errored didn't verify anything, so it isn't a failure either.
+func failing(r JSONCheckResult) string {
+	if r.Passed || r.Skipped || r.Errored {
+		return ""
+	}
+	return r.Severity
+}
+
+// DiffReports compares two reports by check. Results are matched on ID and
+// title, since checks like secrets report several results under one ID. A
+// result that failed before and is missing from the newer report counts as
+// fixed, e.g. a secrets finding that went away.
+func DiffReports(older, newer JSONOutput) Diff {
+	diff := Diff{
+		NewFailures:     []DiffEntry{},
+		Fixed:           []DiffEntry{},
+		SeverityChanged: []DiffEntry{},
+	}
+
+	key := func(r JSONCheckResult) string { return r.ID + "\x00" + r.Title }
+	before := make(map[string]JSONCheckResult)
+	for _, r := range older.Checks {
+		before[key(r)] = r
+	}
+
+	after := make(map[string]bool)
+	for _, r := range newer.Checks {
+		after[key(r)] = true
+		old, seen := before[key(r)]
+		oldSeverity, newSeverity := "", failing(r)
+		if seen {
+			oldSeverity = failing(old)
+		}
+		entry := DiffEntry{ID: r.ID, Title: r.Title, OldSeverity: oldSeverity, NewSeverity: newSeverity, Message: r.Message}
+
+		switch {
+		case oldSeverity == "" && newSeverity != "":
+			diff.NewFailures = append(diff.NewFailures, entry)
+		case oldSeverity != "" && newSeverity == "":
+			diff.Fixed = append(diff.Fixed, entry)
+		case oldSeverity != newSeverity:
+			diff.SeverityChanged = append(diff.SeverityChanged, entry)
+		}
+	}
+
+	for _, r := range older.Checks {
+		if after[key(r)] {
+			continue
+		}
+		if oldSeverity := failing(r); oldSeverity != "" {
+			diff.Fixed = append(diff.Fixed, DiffEntry{ID: r.ID, Title: r.Title, OldSeverity: oldSeverity, Message: "No longer reported"})
+		}
+	}
+	return diff
+}
+
+// PrintDiffText prints a diff for people
+func PrintDiffText(w io.Writer, diff Diff, noColor bool) {
+	p := newPalette(!noColor)
+
+	if len(diff.NewFailures)+len(diff.Fixed)+len(diff.SeverityChanged) == 0 {
+		fmt.Fprintln(w, "No changes between the two reports")
+		return
+	}
+
+	if len(diff.NewFailures) > 0 {
+		fmt.Fprintf(w, "%sNew failures (%d)%s\n", p.bold, len(diff.NewFailures), p.reset)
+		for _, e := range diff.NewFailures {
+			color := p.yellow
+			if e.NewSeverity == "error" {
+				color = p.red
+			}
+			fmt.Fprintf(w, "  %s✗%s %s [%s]: %s\n", color, p.reset, e.Title, e.NewSeverity, e.Message)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(diff.Fixed) > 0 {
+		fmt.Fprintf(w, "%sFixed (%d)%s\n", p.bold, len(diff.Fixed), p.reset)
+		for _, e := range diff.Fixed {
+			fmt.Fprintf(w, "  %s✓%s %s (was %s)\n", p.green, p.reset, e.Title, e.OldSeverity)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(diff.SeverityChanged) > 0 {
+		fmt.Fprintf(w, "%sSeverity changed (%d)%s\n", p.bold, len(diff.SeverityChanged), p.reset)
+		for _, e := range diff.SeverityChanged {
+			fmt.Fprintf(w, "  %s~%s %s: %s → %s\n", p.cyan, p.reset, e.Title, e.OldSeverity, e.NewSeverity)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// PrintDiffJSON prints a diff as indented JSON
+func PrintDiffJSON(w io.Writer, diff Diff) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(diff)
+}
@@ -0,0 +1,72 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDiffReports(t *testing.T) {
+	older := JSONOutput{Checks: []JSONCheckResult{
+		{ID: "ssl", Title: "SSL certificate", Severity: "error", Passed: true},
+		{ID: "sitemap", Title: "sitemap.xml", Severity: "warn"},
+		{ID: "secrets", Title: "Secrets scan", Severity: "error"},
+		{ID: "robots", Title: "robots.txt", Severity: "warn"},
+		{ID: "legacy", Title: "Removed check", Severity: "info", Passed: true},
+	}}
+	newer := JSONOutput{Checks: []JSONCheckResult{
+		{ID: "ssl", Title: "SSL certificate", Severity: "error", Message: "Certificate expired"},
+		{ID: "sitemap", Title: "sitemap.xml", Severity: "warn", Passed: true},
+		{ID: "robots", Title: "robots.txt", Severity: "error"},
+	}}
+
+	diff := DiffReports(older, newer)
+
+	if len(diff.NewFailures) != 1 || diff.NewFailures[0].ID != "ssl" {
+		t.Errorf("newFailures = %+v, want ssl", diff.NewFailures)
+	}
+	if len(diff.Fixed) != 2 || diff.Fixed[0].ID != "sitemap" || diff.Fixed[1].ID != "secrets" {
+		t.Fatalf("fixed = %+v, want sitemap then the removed secrets result", diff.Fixed)
+	}
+	if diff.Fixed[1].OldSeverity != "error" || diff.Fixed[1].NewSeverity != "" {
+		t.Errorf("removed secrets entry = %+v, want error → none", diff.Fixed[1])
+	}
+	if len(diff.SeverityChanged) != 1 || diff.SeverityChanged[0].ID != "robots" {
+		t.Errorf("severityChanged = %+v, want robots", diff.SeverityChanged)
+	}
+}
+
+func TestPrintDiff(t *testing.T) {
+	diff := Diff{
+		NewFailures:     []DiffEntry{{ID: "ssl", Title: "SSL certificate", NewSeverity: "error", Message: "Certificate expired"}},
+		Fixed:           []DiffEntry{{ID: "secrets", Title: "Secrets scan", OldSeverity: "error"}},
+		SeverityChanged: []DiffEntry{},
+	}
+
+	var text bytes.Buffer
+	PrintDiffText(&text, diff, true)
+	for _, want := range []string{"New failures (1)", "SSL certificate [error]: Certificate expired", "Fixed (1)", "Secrets scan (was error)"} {
+		if !strings.Contains(text.String(), want) {
+			t.Errorf("text diff is missing %q:\n%s", want, text.String())
+		}
+	}
+
+	var empty bytes.Buffer
+	PrintDiffText(&empty, Diff{}, true)
+	if !strings.Contains(empty.String(), "No changes") {
+		t.Errorf("empty diff = %q, want a no-changes line", empty.String())
+	}
+
+	var buf bytes.Buffer
+	if err := PrintDiffJSON(&buf, diff); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Diff
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("diff JSON doesn't parse: %v\n%s", err, buf.String())
+	}
+	if len(decoded.NewFailures) != 1 || len(decoded.Fixed) != 1 {
+		t.Errorf("decoded diff = %+v", decoded)
+	}
+}
@@ -3,49 +3,71 @@ package output
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"time"
 
 	"github.com/preflightsh/preflight/internal/checks"
 )
 
-type JSONOutputter struct{}
+type JSONOutputter struct {
+	Version string
+	Strict  bool      // count errored checks in the exit code
+	Writer  io.Writer // nil writes to stdout
+}
 
 type JSONOutput struct {
-	Project string             `json:"project"`
-	Summary Summary            `json:"summary"`
-	Checks  []JSONCheckResult  `json:"checks"`
+	Project     string            `json:"project"`
+	Version     string            `json:"version"`
+	GeneratedAt string            `json:"generatedAt"` // RFC 3339
+	ExitCode    int               `json:"exitCode"`
+	Summary     Summary           `json:"summary"`
+	Checks      []JSONCheckResult `json:"checks"`
 }
 
 type JSONCheckResult struct {
-	ID          string   `json:"id"`
-	Title       string   `json:"title"`
-	Passed      bool     `json:"passed"`
-	Severity    string   `json:"severity"`
-	Message     string   `json:"message,omitempty"`
-	Suggestions []string `json:"suggestions,omitempty"`
+	ID          string            `json:"id"`
+	Title       string            `json:"title"`
+	Passed      bool              `json:"passed"`
+	Skipped     bool              `json:"skipped,omitempty"`
+	Errored     bool              `json:"errored,omitempty"`
+	Severity    string            `json:"severity"`
+	Message     string            `json:"message,omitempty"`
+	Suggestions []string          `json:"suggestions,omitempty"`
+	Locations   []checks.Location `json:"locations,omitempty"`
 }
 
 func (j JSONOutputter) Output(projectName string, results []checks.CheckResult) {
 	output := JSONOutput{
-		Project: projectName,
-		Summary: CalculateSummary(results),
-		Checks:  make([]JSONCheckResult, len(results)),
+		Project:     projectName,
+		Version:     j.Version,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		ExitCode:    ExitCode(results, j.Strict),
+		Summary:     CalculateSummary(results),
+		Checks:      make([]JSONCheckResult, len(results)),
 	}
 
 	for i, r := range results {
-		output.Checks[i] = JSONCheckResult{
-			ID:          r.ID,
-			Title:       r.Title,
-			Passed:      r.Passed,
-			Severity:    string(r.Severity),
-			Message:     r.Message,
-			Suggestions: r.Suggestions,
-		}
+		output.Checks[i] = toJSONCheckResult(r)
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
+	encoder := json.NewEncoder(writerOrStdout(j.Writer))
 	encoder.SetIndent("", "  ")
 	if err := encoder.Encode(output); err != nil {
 		fmt.Fprintf(os.Stderr, "Error encoding JSON: %v\n", err)
 	}
 }
+
+func toJSONCheckResult(r checks.CheckResult) JSONCheckResult {
+	return JSONCheckResult{
+		ID:          r.ID,
+		Title:       r.Title,
+		Passed:      r.Passed,
+		Skipped:     r.Skipped,
+		Errored:     r.Errored,
+		Severity:    string(r.Severity),
+		Message:     r.Message,
+		Suggestions: r.Suggestions,
+		Locations:   r.Locations,
+	}
+}
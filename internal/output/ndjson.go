@@ -0,0 +1,64 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/preflightsh/preflight/internal/checks"
+)
+
+// NDJSONOutputter streams newline-delimited JSON: one "check" object per
+// result as it completes, then a final "summary" object
+type NDJSONOutputter struct {
+	Version string
+	Strict  bool      // count errored checks in the exit code
+	Writer  io.Writer // nil writes to stdout
+}
+
+type NDJSONCheckResult struct {
+	Type string `json:"type"`
+	JSONCheckResult
+}
+
+type NDJSONSummary struct {
+	Type        string  `json:"type"`
+	Project     string  `json:"project"`
+	Version     string  `json:"version"`
+	GeneratedAt string  `json:"generatedAt"` // RFC 3339
+	ExitCode    int     `json:"exitCode"`
+	Summary     Summary `json:"summary"`
+}
+
+func (n NDJSONOutputter) Output(projectName string, results []checks.CheckResult) {
+	for _, r := range results {
+		n.Result(r)
+	}
+	n.Finish(projectName, results)
+}
+
+func (n NDJSONOutputter) Result(result checks.CheckResult) {
+	writeNDJSON(n.Writer, NDJSONCheckResult{
+		Type:            "check",
+		JSONCheckResult: toJSONCheckResult(result),
+	})
+}
+
+func (n NDJSONOutputter) Finish(projectName string, results []checks.CheckResult) {
+	writeNDJSON(n.Writer, NDJSONSummary{
+		Type:        "summary",
+		Project:     projectName,
+		Version:     n.Version,
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		ExitCode:    ExitCode(results, n.Strict),
+		Summary:     CalculateSummary(results),
+	})
+}
+
+func writeNDJSON(w io.Writer, v interface{}) {
+	if err := json.NewEncoder(writerOrStdout(w)).Encode(v); err != nil {
+		fmt.Fprintf(os.Stderr, "Error encoding JSON: %v\n", err)
+	}
+}
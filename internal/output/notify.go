@@ -0,0 +1,154 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/preflightsh/preflight/internal/checks"
+)
+
+// maxNotifyFailures caps how many failing checks are listed in a notification
+// so large regressions don't exceed webhook message limits.
+const maxNotifyFailures = 20
+
+// Notify POSTs a scan summary to a Slack or Discord incoming webhook. The
+// client sets the User-Agent (see checks.WithUserAgent).
+// Discord webhooks are detected by host and receive an embed; every other
+// URL receives a Slack blocks payload.
+func Notify(client *http.Client, webhookURL, projectName string, results []checks.CheckResult) error {
+	summary := CalculateSummary(results)
+
+	var failing []string
+	for _, r := range results {
+		if r.Passed {
+			continue
+		}
+		var label string
+		switch {
+		case r.Errored:
+			label = "ERRORED"
+		case r.Severity == checks.SeverityError:
+			label = "FAIL"
+		case r.Severity == checks.SeverityWarn:
+			label = "WARN"
+		default:
+			continue
+		}
+		failing = append(failing, fmt.Sprintf("%s %s", label, r.Title))
+	}
+	if len(failing) > maxNotifyFailures {
+		more := len(failing) - maxNotifyFailures
+		failing = append(failing[:maxNotifyFailures], fmt.Sprintf("…and %d more", more))
+	}
+
+	var payload interface{}
+	if isDiscordWebhook(webhookURL) {
+		payload = discordPayload(projectName, summary, failing)
+	} else {
+		payload = slackPayload(projectName, summary, failing)
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("invalid notify URL: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook returned %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func isDiscordWebhook(webhookURL string) bool {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	return host == "discord.com" || host == "discordapp.com" ||
+		strings.HasSuffix(host, ".discord.com") || strings.HasSuffix(host, ".discordapp.com")
+}
+
+func notifyHeadline(projectName string, summary Summary) string {
+	switch {
+	case summary.Fail > 0:
+		return fmt.Sprintf("✗ Preflight: %s is not ready for launch", projectName)
+	case summary.Warn > 0:
+		return fmt.Sprintf("⚠ Preflight: %s has warnings to review", projectName)
+	default:
+		return fmt.Sprintf("✓ Preflight: %s is ready for launch", projectName)
+	}
+}
+
+func notifyCounts(summary Summary) string {
+	line := fmt.Sprintf("Passed: %d · Warnings: %d · Failed: %d", summary.OK, summary.Warn, summary.Fail)
+	if summary.Skip > 0 {
+		line += fmt.Sprintf(" · Skipped: %d", summary.Skip)
+	}
+	return line
+}
+
+func slackPayload(projectName string, summary Summary, failing []string) map[string]interface{} {
+	headline := notifyHeadline(projectName, summary)
+	blocks := []map[string]interface{}{
+		{
+			"type": "header",
+			"text": map[string]interface{}{"type": "plain_text", "text": headline},
+		},
+		{
+			"type": "section",
+			"text": map[string]interface{}{"type": "mrkdwn", "text": notifyCounts(summary)},
+		},
+	}
+	if len(failing) > 0 {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "section",
+			"text": map[string]interface{}{"type": "mrkdwn", "text": "• " + strings.Join(failing, "\n• ")},
+		})
+	}
+
+	return map[string]interface{}{
+		"text":   headline,
+		"blocks": blocks,
+	}
+}
+
+func discordPayload(projectName string, summary Summary, failing []string) map[string]interface{} {
+	color := 0x2ecc71 // green
+	if summary.Fail > 0 {
+		color = 0xe74c3c // red
+	} else if summary.Warn > 0 {
+		color = 0xf1c40f // yellow
+	}
+
+	description := notifyCounts(summary)
+	if len(failing) > 0 {
+		description += "\n\n• " + strings.Join(failing, "\n• ")
+	}
+
+	return map[string]interface{}{
+		"embeds": []map[string]interface{}{
+			{
+				"title":       notifyHeadline(projectName, summary),
+				"description": description,
+				"color":       color,
+			},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package output
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/preflightsh/preflight/internal/checks"
+)
+
+func TestNotifySendsConfiguredUserAgent(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+	}))
+	defer server.Close()
+
+	for ua, want := range map[string]string{"": checks.DefaultUserAgent, "Mozilla/5.0 (compatible; Preflight)": "Mozilla/5.0 (compatible; Preflight)"} {
+		client := checks.WithUserAgent(checks.NewHTTPClient(time.Second), ua)
+		if err := Notify(client, server.URL, "demo", sampleResults()); err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("User-Agent = %q, want %q", got, want)
+		}
+	}
+}
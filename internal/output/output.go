@@ -1,22 +1,123 @@
 package output
 
-import "github.com/preflightsh/preflight/internal/checks"
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/preflightsh/preflight/internal/checks"
+)
 
 type Outputter interface {
 	Output(projectName string, results []checks.CheckResult)
 }
 
+// StreamingOutputter writes each result as soon as its check finishes. The
+// scan calls Result once per result, then Finish with everything collected.
+type StreamingOutputter interface {
+	Outputter
+	Result(result checks.CheckResult)
+	Finish(projectName string, results []checks.CheckResult)
+}
+
+// Options are the display settings passed to each format's constructor
+type Options struct {
+	Verbose bool
+	NoColor bool
+	Quiet   bool
+	Version string // preflight version, recorded in machine-readable output
+	Strict  bool   // errored checks fail the scan (--strict)
+
+	// Writer receives the report; nil means stdout
+	Writer io.Writer
+}
+
+// formats maps each --format name to its outputter. New formats only need
+// an entry here.
+var formats = map[string]func(Options) Outputter{
+	"text": func(o Options) Outputter {
+		return TextOutputter{Verbose: o.Verbose, NoColor: o.NoColor, Quiet: o.Quiet, Strict: o.Strict, Writer: o.Writer}
+	},
+	"json": func(o Options) Outputter {
+		return JSONOutputter{Version: o.Version, Strict: o.Strict, Writer: o.Writer}
+	},
+	"ndjson": func(o Options) Outputter {
+		return NDJSONOutputter{Version: o.Version, Strict: o.Strict, Writer: o.Writer}
+	},
+}
+
+// writerOrStdout returns w, or stdout when w is nil
+func writerOrStdout(w io.Writer) io.Writer {
+	if w == nil {
+		return os.Stdout
+	}
+	return w
+}
+
+// formatAliases are accepted names that map to another format
+var formatAliases = map[string]string{
+	"human": "text",
+}
+
+// Formats returns the supported --format names, sorted
+func Formats() []string {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// New returns the outputter for a --format name
+func New(format string, opts Options) (Outputter, error) {
+	format = strings.ToLower(format)
+	if alias, ok := formatAliases[format]; ok {
+		format = alias
+	}
+	newOutputter, ok := formats[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+	return newOutputter(opts), nil
+}
+
 type Summary struct {
-	OK   int `json:"ok"`
-	Warn int `json:"warn"`
-	Fail int `json:"fail"`
+	OK      int `json:"ok"`
+	Warn    int `json:"warn"`
+	Fail    int `json:"fail"`
+	Skip    int `json:"skip"`
+	Errored int `json:"errored"`
+}
+
+// TopIssue returns the most severe failing result: the first error, or else
+// the first warning. ok is false when nothing failed.
+func TopIssue(results []checks.CheckResult) (top checks.CheckResult, ok bool) {
+	for _, r := range results {
+		if r.Passed || r.Skipped || r.Errored {
+			continue
+		}
+		if r.Severity == checks.SeverityError {
+			return r, true
+		}
+		if !ok && r.Severity == checks.SeverityWarn {
+			top, ok = r, true
+		}
+	}
+	return top, ok
 }
 
 func CalculateSummary(results []checks.CheckResult) Summary {
 	var summary Summary
 
 	for _, r := range results {
-		if r.Passed {
+		if r.Skipped {
+			summary.Skip++
+		} else if r.Errored {
+			summary.Errored++
+		} else if r.Passed {
 			summary.OK++
 		} else {
 			switch r.Severity {
@@ -32,3 +133,34 @@ func CalculateSummary(results []checks.CheckResult) Summary {
 
 	return summary
 }
+
+// ExitCode is the scan's process exit code: 2 if any check failed with an
+// error, 1 if any failed with a warning, otherwise 0. Checks that errored
+// count as errors only when strict is set.
+func ExitCode(results []checks.CheckResult, strict bool) int {
+	hasError := false
+	hasWarning := false
+
+	for _, r := range results {
+		if r.Errored {
+			hasError = hasError || strict
+			continue
+		}
+		if !r.Passed {
+			switch r.Severity {
+			case checks.SeverityError:
+				hasError = true
+			case checks.SeverityWarn:
+				hasWarning = true
+			}
+		}
+	}
+
+	if hasError {
+		return 2
+	}
+	if hasWarning {
+		return 1
+	}
+	return 0
+}
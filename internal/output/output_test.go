@@ -0,0 +1,149 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/preflightsh/preflight/internal/checks"
+)
+
+// sampleResults has one result of each kind the summary counts
+func sampleResults() []checks.CheckResult {
+	return []checks.CheckResult{
+		{ID: "ssl", Title: "SSL certificate", Severity: checks.SeverityInfo, Passed: true, Message: "Valid certificate"},
+		{ID: "sitemap", Title: "sitemap.xml", Severity: checks.SeverityWarn, Message: "sitemap.xml not found",
+			Suggestions: []string{"Add a sitemap.xml"}},
+		{ID: "secrets", Title: "Secrets scan", Severity: checks.SeverityError, Message: "Potential secrets found",
+			Locations: []checks.Location{{File: ".env", Line: 2, Message: "AWS Access Key ID"}}},
+		{ID: "hreflang", Title: "hreflang tags", Severity: checks.SeverityInfo, Passed: true, Skipped: true,
+			Message: "No hreflang tags, skipping"},
+		{ID: "cors", Title: "CORS", Severity: checks.SeverityError, Errored: true, Message: "Check panicked: boom"},
+	}
+}
+
+var sampleSummary = Summary{OK: 1, Warn: 1, Fail: 1, Skip: 1, Errored: 1}
+
+func render(t *testing.T, format string, opts Options, results []checks.CheckResult) string {
+	t.Helper()
+	var buf bytes.Buffer
+	opts.Writer = &buf
+	opts.NoColor = true
+	outputter, err := New(format, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputter.Output("demo", results)
+	return buf.String()
+}
+
+func TestJSONOutput(t *testing.T) {
+	out := render(t, "json", Options{Version: "1.2.3"}, sampleResults())
+
+	var report JSONOutput
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("output isn't valid JSON: %v\n%s", err, out)
+	}
+	if report.Project != "demo" || report.Version != "1.2.3" {
+		t.Errorf("project/version = %q/%q, want demo/1.2.3", report.Project, report.Version)
+	}
+	if report.Summary != sampleSummary {
+		t.Errorf("summary = %+v, want %+v", report.Summary, sampleSummary)
+	}
+	if report.ExitCode != 2 {
+		t.Errorf("exitCode = %d, want 2", report.ExitCode)
+	}
+	if len(report.Checks) != 5 {
+		t.Fatalf("got %d checks, want 5", len(report.Checks))
+	}
+	if c := report.Checks[2]; c.ID != "secrets" || len(c.Locations) != 1 || c.Locations[0].Line != 2 {
+		t.Errorf("secrets check = %+v, want its location on line 2", c)
+	}
+	if !report.Checks[4].Errored {
+		t.Errorf("cors check isn't marked errored")
+	}
+}
+
+func TestJSONExitCodeStrict(t *testing.T) {
+	errored := []checks.CheckResult{sampleResults()[0], sampleResults()[4]}
+
+	var report JSONOutput
+	if err := json.Unmarshal([]byte(render(t, "json", Options{}, errored)), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.ExitCode != 0 {
+		t.Errorf("exitCode = %d, want 0 without --strict", report.ExitCode)
+	}
+	if err := json.Unmarshal([]byte(render(t, "json", Options{Strict: true}, errored)), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.ExitCode != 2 {
+		t.Errorf("exitCode = %d, want 2 with --strict", report.ExitCode)
+	}
+}
+
+func TestNDJSONOutput(t *testing.T) {
+	out := render(t, "ndjson", Options{Version: "1.2.3"}, sampleResults())
+
+	var lines []string
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if len(lines) != 6 {
+		t.Fatalf("got %d lines, want one per check plus a summary:\n%s", len(lines), out)
+	}
+
+	for i, line := range lines[:5] {
+		var check NDJSONCheckResult
+		if err := json.Unmarshal([]byte(line), &check); err != nil {
+			t.Fatalf("line %d isn't a JSON object: %v", i+1, err)
+		}
+		if check.Type != "check" || check.ID != sampleResults()[i].ID {
+			t.Errorf("line %d = type %q id %q, want check %q", i+1, check.Type, check.ID, sampleResults()[i].ID)
+		}
+	}
+
+	var summary NDJSONSummary
+	if err := json.Unmarshal([]byte(lines[5]), &summary); err != nil {
+		t.Fatalf("last line isn't a JSON object: %v", err)
+	}
+	if summary.Type != "summary" || summary.Summary != sampleSummary || summary.ExitCode != 2 {
+		t.Errorf("summary line = %+v, want summary %+v with exitCode 2", summary, sampleSummary)
+	}
+}
+
+func TestTextOutput(t *testing.T) {
+	out := render(t, "text", Options{Verbose: true}, sampleResults())
+
+	for _, want := range []string{
+		"Project: demo",
+		"SSL certificate",
+		"sitemap.xml not found",
+		".env:2",
+		"‼  Errored",
+		"Check panicked: boom",
+		"⏭  Skipped",
+		"No hreflang tags, skipping",
+		"✗ Not ready for launch",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("text output is missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "\033[") {
+		t.Errorf("text output has color codes with NoColor set")
+	}
+}
+
+func TestTextOutputQuietHidesPassed(t *testing.T) {
+	out := render(t, "text", Options{Quiet: true}, sampleResults())
+	if strings.Contains(out, "SSL certificate") {
+		t.Errorf("quiet output shows a passed check:\n%s", out)
+	}
+	if !strings.Contains(out, "sitemap.xml not found") {
+		t.Errorf("quiet output hides a warning:\n%s", out)
+	}
+}
@@ -0,0 +1,298 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/preflightsh/preflight/internal/checks"
+)
+
+// palette holds the ANSI escape sequences used for text output.
+// All fields are empty when color is disabled.
+type palette struct {
+	reset  string
+	red    string
+	green  string
+	yellow string
+	blue   string
+	cyan   string
+	gray   string
+	bold   string
+}
+
+func newPalette(enabled bool) palette {
+	if !enabled {
+		return palette{}
+	}
+	return palette{
+		reset:  "\033[0m",
+		red:    "\033[31m",
+		green:  "\033[32m",
+		yellow: "\033[33m",
+		blue:   "\033[34m",
+		cyan:   "\033[36m",
+		gray:   "\033[90m",
+		bold:   "\033[1m",
+	}
+}
+
+// TextOutputter prints grouped, human-readable results (--format text, the
+// default). Details and Locations are shown when Verbose is set.
+type TextOutputter struct {
+	Verbose bool
+	NoColor bool
+	Quiet   bool      // Only print warnings, errors and the summary
+	Strict  bool      // Errored checks block the launch (--strict)
+	Writer  io.Writer // nil writes to stdout
+}
+
+func (h TextOutputter) Output(projectName string, results []checks.CheckResult) {
+	w := writerOrStdout(h.Writer)
+	p := newPalette(!h.NoColor)
+	// Header
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%s%s ✈  Preflight Scan Results%s\n", p.bold, p.cyan, p.reset)
+	fmt.Fprintf(w, "%s   Project: %s%s\n", p.gray, projectName, p.reset)
+	fmt.Fprintln(w)
+
+	// Category icons
+	categoryIcons := map[string]string{
+		"ENV":       "📋",
+		"HEALTH":    "💓",
+		"PAYMENTS":  "💳",
+		"ERRORS":    "🐛",
+		"ANALYTICS": "📊",
+		"INFRA":     "🔧",
+		"JOBS":      "⚡",
+		"SEO":       "🔍",
+		"SECURITY":  "🔒",
+		"SECRETS":   "🔑",
+		"AI":        "🤖",
+		"EMAIL":     "📧",
+		"AUTH":      "🔐",
+		"STORAGE":   "📦",
+		"SEARCH":    "🔎",
+		"CHAT":      "💬",
+		"NOTIFY":    "🔔",
+		"SOCIAL":    "📱",
+		"ICONS":     "🎨",
+		"FILES":     "📄",
+		"SSL":       "🔐",
+		"LICENSE":   "📜",
+		"DEPS":      "📦",
+		"INDEXNOW":  "🔗",
+		"MOBILE":    "📱",
+		"LANG":      "🌐",
+		"PAGES":     "📃",
+		"DEBUG":     "🐞",
+		"PERF":      "⚡",
+		"LEGAL":     "⚖️ ",
+	}
+
+	// Separate results into non-service checks and service checks
+	// Also filter out skipped checks entirely, and passed checks in quiet mode
+	var coreResults []checks.CheckResult
+	var serviceResults []checks.CheckResult
+	var skippedResults []checks.CheckResult
+	var erroredResults []checks.CheckResult
+	for _, r := range results {
+		// Skipped and errored checks get their own sections below
+		if r.Skipped {
+			skippedResults = append(skippedResults, r)
+			continue
+		}
+		if r.Errored {
+			erroredResults = append(erroredResults, r)
+			continue
+		}
+		if h.Quiet && r.Passed {
+			continue
+		}
+		if checks.IsService(r.ID) {
+			serviceResults = append(serviceResults, r)
+		} else {
+			coreResults = append(coreResults, r)
+		}
+	}
+
+	// Helper function to print a check result
+	printResult := func(r checks.CheckResult, isLast bool) {
+		category := checks.Category(r.ID)
+
+		icon := categoryIcons[category]
+		if icon == "" {
+			icon = "•"
+		}
+
+		status := formatStatus(r, p)
+		categoryLabel := fmt.Sprintf("%s  %-10s", icon, category)
+
+		fmt.Fprintf(w, "  %s %s%-45s%s %s\n", categoryLabel, p.reset, r.Title, p.reset, status)
+
+		// Show message for failed checks, or for passed checks with useful info
+		if r.Message != "" {
+			if !r.Passed {
+				fmt.Fprintf(w, "  %s                  └─ %s%s\n", p.gray, r.Message, p.reset)
+			} else if hasUsefulPassedMessage(r.Message) {
+				fmt.Fprintf(w, "  %s                  └─ %s%s\n", p.gray, r.Message, p.reset)
+			}
+		}
+
+		// Show verbose details if enabled
+		if h.Verbose && len(r.Details) > 0 {
+			for _, detail := range r.Details {
+				fmt.Fprintf(w, "  %s                  │  %s%s\n", p.gray, detail, p.reset)
+			}
+		}
+		if h.Verbose && len(r.Locations) > 0 {
+			for _, loc := range r.Locations {
+				fmt.Fprintf(w, "  %s                  │  %s%s\n", p.gray, loc, p.reset)
+			}
+		}
+
+		// Add subtle divider between checks (except after the last one)
+		if !isLast {
+			fmt.Fprintf(w, "  %s· · · · · · · · · · · · · · · · · · · · · · · · · · · ·%s\n", p.gray, p.reset)
+		}
+	}
+
+	// Print core check results
+	for i, r := range coreResults {
+		isLast := i == len(coreResults)-1 && len(serviceResults) == 0
+		printResult(r, isLast)
+	}
+
+	// Print service check results under a heading
+	if len(serviceResults) > 0 {
+		if len(coreResults) > 0 {
+			fmt.Fprintln(w)
+			fmt.Fprintf(w, "  %s────────────────────────────────────────────────────────%s\n", p.gray, p.reset)
+		}
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "%s%s 🔌 Checked Services%s\n", p.bold, p.cyan, p.reset)
+		fmt.Fprintln(w)
+
+		for i, r := range serviceResults {
+			isLast := i == len(serviceResults)-1
+			printResult(r, isLast)
+		}
+	}
+
+	// Checks that broke verified nothing; keep them apart from real findings
+	if len(erroredResults) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "  %s────────────────────────────────────────────────────────%s\n", p.gray, p.reset)
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "%s%s ‼  Errored%s\n", p.bold, p.red, p.reset)
+		fmt.Fprintln(w)
+		for _, r := range erroredResults {
+			fmt.Fprintf(w, "  %s‼%s %-45s %s%s%s\n", p.red, p.reset, r.Title, p.gray, r.Message, p.reset)
+			if h.Verbose {
+				for _, detail := range r.Details {
+					fmt.Fprintf(w, "  %s                  │  %s%s\n", p.gray, detail, p.reset)
+				}
+			}
+		}
+		fmt.Fprintf(w, "\n  %sThese checks broke while running, so their results are unknown. Please report them.%s\n", p.gray, p.reset)
+	}
+
+	// List skipped checks so the summary is honest about coverage
+	if len(skippedResults) > 0 && !h.Quiet {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "  %s────────────────────────────────────────────────────────%s\n", p.gray, p.reset)
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "%s%s ⏭  Skipped%s\n", p.bold, p.gray, p.reset)
+		fmt.Fprintln(w)
+		for _, r := range skippedResults {
+			fmt.Fprintf(w, "  %s– %-45s %s%s\n", p.gray, r.Title, r.Message, p.reset)
+		}
+	}
+
+	// Summary
+	summary := CalculateSummary(results)
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "  %s────────────────────────────────────────────────────────%s\n", p.gray, p.reset)
+	fmt.Fprintln(w)
+
+	// Summary with icons
+	fmt.Fprintf(w, "  %s✓ Passed:%s  %s%d%s", p.green, p.reset, p.bold, summary.OK, p.reset)
+	if summary.Warn > 0 {
+		fmt.Fprintf(w, "    %s⚠ Warnings:%s %s%d%s", p.yellow, p.reset, p.bold, summary.Warn, p.reset)
+	}
+	if summary.Fail > 0 {
+		fmt.Fprintf(w, "    %s✗ Failed:%s  %s%d%s", p.red, p.reset, p.bold, summary.Fail, p.reset)
+	}
+	if summary.Skip > 0 {
+		fmt.Fprintf(w, "    %s⏭ Skipped:%s %s%d%s", p.gray, p.reset, p.bold, summary.Skip, p.reset)
+	}
+	if summary.Errored > 0 {
+		fmt.Fprintf(w, "    %s‼ Errored:%s %s%d%s", p.red, p.reset, p.bold, summary.Errored, p.reset)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w)
+
+	// Final verdict
+	if summary.Fail > 0 || (h.Strict && summary.Errored > 0) {
+		fmt.Fprintf(w, "  %s%s✗ Not ready for launch%s\n", p.bold, p.red, p.reset)
+	} else if summary.Warn > 0 {
+		fmt.Fprintf(w, "  %s%s⚠ Review warnings before launch%s\n", p.bold, p.yellow, p.reset)
+	} else {
+		fmt.Fprintf(w, "  %s%s✓ Ready for launch!%s\n", p.bold, p.green, p.reset)
+	}
+	fmt.Fprintln(w)
+
+	// Point new users at the one thing to fix first
+	if top, ok := TopIssue(results); ok {
+		fmt.Fprintf(w, "  Start with %s%s%s: %s\n", p.bold, top.Title, p.reset, top.Message)
+		if len(top.Suggestions) > 0 {
+			fmt.Fprintf(w, "    %s→ %s%s\n", p.cyan, top.Suggestions[0], p.reset)
+		}
+		if h.Verbose {
+			fmt.Fprintf(w, "  %sNot relevant? Run `preflight ignore %s` to skip it%s\n", p.gray, top.ID, p.reset)
+		} else {
+			fmt.Fprintf(w, "  %sRun `preflight scan --verbose` for details, or `preflight ignore %s` to skip it%s\n", p.gray, top.ID, p.reset)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// hasUsefulPassedMessage returns true if the message contains info worth showing
+// even when the check passed (e.g., license type, version info)
+func hasUsefulPassedMessage(msg string) bool {
+	// Show messages that identify specific types/versions
+	usefulPatterns := []string{
+		"license found",  // License type detection
+		"MIT", "Apache", "GPL", "AGPL", "BSD", "ISC", "MPL",
+		"(at ",           // Location info for files found in parent dirs
+		"not enabled",    // Check passed because it's disabled/not configured
+		"not configured", // Check passed because it's not configured
+		"skipped",        // Check was skipped
+		"not declared",   // Service not declared
+	}
+
+	msgLower := strings.ToLower(msg)
+	for _, pattern := range usefulPatterns {
+		if strings.Contains(msgLower, strings.ToLower(pattern)) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatStatus(r checks.CheckResult, p palette) string {
+	if r.Passed {
+		return fmt.Sprintf("%s%s✓ OK%s", p.bold, p.green, p.reset)
+	}
+
+	switch r.Severity {
+	case checks.SeverityError:
+		return fmt.Sprintf("%s%s✗ FAIL%s", p.bold, p.red, p.reset)
+	case checks.SeverityWarn:
+		return fmt.Sprintf("%s%s⚠ WARN%s", p.bold, p.yellow, p.reset)
+	case checks.SeverityInfo:
+		return fmt.Sprintf("%s%sℹ INFO%s", p.bold, p.cyan, p.reset)
+	default:
+		return fmt.Sprintf("%s%s⚠ WARN%s", p.bold, p.yellow, p.reset)
+	}
+}
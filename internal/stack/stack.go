@@ -0,0 +1,554 @@
+// Package stack detects which framework or platform a project is built with.
+package stack
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Supported lists the stack values accepted in config
+var Supported = []string{
+	// Backend frameworks
+	"rails", "laravel", "php", "go", "python", "django", "rust", "node",
+	// Frontend frameworks
+	"next", "nuxt", "remix", "react", "vue", "vite", "svelte", "angular",
+	// Traditional CMS
+	"wordpress", "craft", "drupal", "ghost",
+	// Static site generators
+	"hugo", "jekyll", "gatsby", "eleventy", "astro",
+	// Headless CMS
+	"strapi", "sanity", "contentful", "prismic",
+	// Other
+	"static",
+}
+
+// DetectStack determines the project stack based on files present
+func DetectStack(rootDir string) string {
+	// Check for Rails
+	if fileExists(rootDir, "Gemfile") && (fileExists(rootDir, "config/routes.rb") || hasGem(rootDir, "rails")) {
+		return "rails"
+	}
+
+	// Dependencies declared in package.json, used to identify JS frameworks
+	deps := packageDependencies(rootDir)
+
+	// Check for Next.js declared as a dependency without a next.config file
+	if deps["next"] {
+		return "next"
+	}
+
+	// Check for Next.js (including monorepo structures)
+	if fileExists(rootDir, "next.config.js") || fileExists(rootDir, "next.config.mjs") || fileExists(rootDir, "next.config.ts") {
+		return "next"
+	}
+	// Check monorepo structures for Next.js
+	if hasMonorepoFramework(rootDir, []string{"next.config.js", "next.config.mjs", "next.config.ts"}) {
+		return "next"
+	}
+
+	// Check for Laravel
+	if fileExists(rootDir, "artisan") && fileExists(rootDir, "composer.json") ||
+		composerRequires(rootDir, "laravel/framework") {
+		return "laravel"
+	}
+
+	// === Traditional CMS ===
+
+	// Check for WordPress
+	if fileExists(rootDir, "wp-config.php") || fileExists(rootDir, "wp-content/themes") {
+		return "wordpress"
+	}
+
+	// Check for Craft CMS
+	if fileExists(rootDir, "craft") || fileContains(rootDir, "composer.json", "craftcms/cms") {
+		return "craft"
+	}
+
+	// Check for Drupal
+	if fileExists(rootDir, "core/lib/Drupal.php") || (fileExists(rootDir, "sites/default") && fileExists(rootDir, "core")) {
+		return "drupal"
+	}
+
+	// Check for Ghost (before generic Node.js check)
+	if fileContains(rootDir, "package.json", "\"ghost\"") || fileExists(rootDir, "content/themes") {
+		return "ghost"
+	}
+
+	// === Static Site Generators ===
+
+	// Check for Hugo
+	if fileExists(rootDir, "hugo.toml") || fileExists(rootDir, "hugo.yaml") || fileExists(rootDir, "hugo.json") ||
+		(fileExists(rootDir, "config.toml") && fileExists(rootDir, "content") && fileExists(rootDir, "themes")) {
+		return "hugo"
+	}
+
+	// Check for Jekyll
+	if fileExists(rootDir, "_config.yml") && (fileExists(rootDir, "_posts") || fileExists(rootDir, "_layouts")) {
+		return "jekyll"
+	}
+
+	// Check for Gatsby
+	if deps["gatsby"] || fileExists(rootDir, "gatsby-config.js") || fileExists(rootDir, "gatsby-config.ts") || fileExists(rootDir, "gatsby-config.mjs") {
+		return "gatsby"
+	}
+
+	// Check for Eleventy (11ty)
+	if fileExists(rootDir, ".eleventy.js") || fileExists(rootDir, "eleventy.config.js") || fileExists(rootDir, "eleventy.config.mjs") ||
+		fileContains(rootDir, "package.json", "@11ty/eleventy") {
+		return "eleventy"
+	}
+
+	// Check for Astro
+	if deps["astro"] || fileExists(rootDir, "astro.config.mjs") || fileExists(rootDir, "astro.config.ts") || fileExists(rootDir, "astro.config.js") {
+		return "astro"
+	}
+
+	// === Headless CMS ===
+
+	// Check for Strapi
+	if fileContains(rootDir, "package.json", "@strapi/strapi") || fileExists(rootDir, "src/api") && fileExists(rootDir, "config/database.js") {
+		return "strapi"
+	}
+
+	// Check for Sanity
+	if fileExists(rootDir, "sanity.json") || fileExists(rootDir, "sanity.config.ts") || fileExists(rootDir, "sanity.config.js") ||
+		fileContains(rootDir, "package.json", "sanity") {
+		return "sanity"
+	}
+
+	// Check for Contentful (usually detected via env vars, but check for config)
+	if fileContains(rootDir, "package.json", "contentful") {
+		return "contentful"
+	}
+
+	// Check for Prismic
+	if fileExists(rootDir, "prismicio.js") || fileExists(rootDir, "slicemachine.config.json") ||
+		fileContains(rootDir, "package.json", "@prismicio") {
+		return "prismic"
+	}
+
+	// === General Stacks ===
+
+	// Check for Go
+	if fileExists(rootDir, "go.mod") {
+		return "go"
+	}
+
+	// Check for Python (Django/Flask)
+	if fileExists(rootDir, "requirements.txt") || fileExists(rootDir, "pyproject.toml") || fileExists(rootDir, "Pipfile") {
+		if fileExists(rootDir, "manage.py") {
+			return "django"
+		}
+		return "python"
+	}
+
+	// Check for Rust
+	if fileExists(rootDir, "Cargo.toml") {
+		return "rust"
+	}
+
+	// Check for basic PHP site (before Node.js, since PHP sites often use Node for build tools)
+	if fileExists(rootDir, "public/index.php") || fileExists(rootDir, "index.php") || fileExists(rootDir, "web/index.php") {
+		// Not a known PHP framework, just a plain PHP site
+		return "php"
+	}
+
+	// Check for Node.js frameworks
+	if fileExists(rootDir, "package.json") {
+		// Check for Vite
+		if fileExists(rootDir, "vite.config.js") || fileExists(rootDir, "vite.config.ts") || fileExists(rootDir, "vite.config.mjs") {
+			return "vite"
+		}
+
+		// Check for specific frameworks in package.json
+		if pkgJSON, err := os.ReadFile(filepath.Join(rootDir, "package.json")); err == nil {
+			content := string(pkgJSON)
+			// Check for React
+			if deps["react"] {
+				return "react"
+			}
+			// Check for Vue
+			if deps["vue"] {
+				return "vue"
+			}
+			// Check for Svelte
+			if deps["svelte"] || deps["@sveltejs/kit"] {
+				return "svelte"
+			}
+			// Check for Angular
+			if deps["@angular/core"] {
+				return "angular"
+			}
+			// Check for Vite without a vite.config file
+			if deps["vite"] {
+				return "vite"
+			}
+
+			// Check if it's a static site with build tools (e.g., Tailwind)
+			if hasHTMLFiles(rootDir) && isStaticSiteWithBuildTools(content) {
+				return "static"
+			}
+
+			// Only return "node" if there are actual Node.js app indicators
+			if isNodeApp(rootDir, content) {
+				return "node"
+			}
+		}
+
+		// If package.json exists but no Node.js app indicators, check for static site
+		if hasHTMLFiles(rootDir) {
+			return "static"
+		}
+
+		return "node"
+	}
+
+	// Check for static site
+	if fileExists(rootDir, "index.html") {
+		return "static"
+	}
+
+	return "unknown"
+}
+
+// fileContains checks if a file exists and contains a specific string
+func fileContains(rootDir, relativePath, search string) bool {
+	path := filepath.Join(rootDir, relativePath)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(content), search)
+}
+
+// hasMonorepoFramework checks if any monorepo subdirectory contains the specified files
+func hasMonorepoFramework(rootDir string, files []string) bool {
+	monorepoRoots := []string{"apps", "packages", "services"}
+	for _, monoRoot := range monorepoRoots {
+		monoDir := filepath.Join(rootDir, monoRoot)
+		entries, err := os.ReadDir(monoDir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			for _, file := range files {
+				if fileExists(filepath.Join(monoDir, entry.Name()), file) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// hasHTMLFiles checks if the project root or common web directories contain HTML files
+func hasHTMLFiles(rootDir string) bool {
+	// Check root directory
+	if fileExists(rootDir, "index.html") {
+		return true
+	}
+	// Check common static site directories
+	staticDirs := []string{"public", "dist", "build", "www", "static", "_site", "out"}
+	for _, dir := range staticDirs {
+		if fileExists(rootDir, filepath.Join(dir, "index.html")) {
+			return true
+		}
+	}
+	return false
+}
+
+// isStaticSiteWithBuildTools checks if package.json only contains build/dev tools
+// like Tailwind, PostCSS, etc. without any runtime Node.js dependencies
+func isStaticSiteWithBuildTools(packageJSON string) bool {
+	// Build tools that don't indicate a Node.js app
+	buildTools := []string{
+		"tailwindcss",
+		"postcss",
+		"autoprefixer",
+		"sass",
+		"less",
+		"stylus",
+		"cssnano",
+		"purgecss",
+		"@tailwindcss/",
+		"prettier",
+		"eslint",
+		"stylelint",
+		"webpack",
+		"parcel",
+		"rollup",
+		"esbuild",
+		"terser",
+		"uglify",
+		"babel",
+		"typescript",
+		"live-server",
+		"browser-sync",
+		"http-server",
+		"serve",
+		"concurrently",
+		"npm-run-all",
+		"cross-env",
+		"dotenv",
+		"husky",
+		"lint-staged",
+	}
+
+	// Node.js app indicators that would disqualify as static
+	appIndicators := []string{
+		"express",
+		"koa",
+		"fastify",
+		"hapi",
+		"@hapi/hapi",
+		"nest",
+		"@nestjs/",
+		"restify",
+		"polka",
+		"micro",
+		"moleculer",
+		"feathers",
+		"@feathersjs/",
+		"loopback",
+		"adonis",
+		"@adonisjs/",
+		"sails",
+		"meteor",
+		"socket.io",
+		"ws\"",
+		"graphql",
+		"apollo-server",
+		"prisma",
+		"sequelize",
+		"typeorm",
+		"mongoose",
+		"mongodb",
+		"pg\"",
+		"mysql",
+		"redis\"",
+		"ioredis",
+		"bull",
+		"agenda",
+		"node-cron",
+		"puppeteer",
+		"playwright",
+		"electron",
+		"\"react\"",
+		"\"vue\"",
+		"\"svelte\"",
+		"\"@angular/core\"",
+		"\"next\"",
+		"nuxt",
+		"gatsby",
+		"remix",
+		"@remix-run",
+		"solid-js",
+		"qwik",
+		"preact",
+	}
+
+	content := strings.ToLower(packageJSON)
+
+	// If any app indicators are present, it's not a static site with build tools
+	for _, indicator := range appIndicators {
+		if strings.Contains(content, strings.ToLower(indicator)) {
+			return false
+		}
+	}
+
+	// Check if at least one build tool is present
+	for _, tool := range buildTools {
+		if strings.Contains(content, strings.ToLower(tool)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isNodeApp checks for indicators that this is actually a Node.js application
+func isNodeApp(rootDir string, packageJSON string) bool {
+	// Check for common Node.js entry point files
+	entryPoints := []string{
+		"server.js",
+		"server.ts",
+		"app.js",
+		"app.ts",
+		"index.js",
+		"index.ts",
+		"main.js",
+		"main.ts",
+		"src/index.js",
+		"src/index.ts",
+		"src/server.js",
+		"src/server.ts",
+		"src/app.js",
+		"src/app.ts",
+	}
+
+	for _, entry := range entryPoints {
+		if fileExists(rootDir, entry) {
+			// index.js/ts alone isn't enough - could be a build tool config
+			// Check if it looks like an app entry point
+			if entry == "index.js" || entry == "index.ts" {
+				content, err := os.ReadFile(filepath.Join(rootDir, entry))
+				if err == nil {
+					contentStr := string(content)
+					// Look for server/app patterns
+					if strings.Contains(contentStr, "listen(") ||
+						strings.Contains(contentStr, "createServer") ||
+						strings.Contains(contentStr, "express()") ||
+						strings.Contains(contentStr, "new Koa") ||
+						strings.Contains(contentStr, "fastify(") {
+						return true
+					}
+				}
+				continue
+			}
+			return true
+		}
+	}
+
+	content := strings.ToLower(packageJSON)
+
+	// Check for Node.js server frameworks in dependencies
+	serverFrameworks := []string{
+		"\"express\"",
+		"\"koa\"",
+		"\"fastify\"",
+		"\"hapi\"",
+		"\"@hapi/hapi\"",
+		"\"@nestjs/core\"",
+		"\"restify\"",
+		"\"polka\"",
+		"\"micro\"",
+		"\"moleculer\"",
+		"\"@feathersjs/feathers\"",
+		"\"loopback\"",
+		"\"@adonisjs/core\"",
+		"\"sails\"",
+		"\"socket.io\"",
+	}
+
+	for _, framework := range serverFrameworks {
+		if strings.Contains(content, framework) {
+			return true
+		}
+	}
+
+	// Check for "start" script that runs node
+	// Look for patterns like "node ", "ts-node", "nodemon"
+	startPatterns := []string{
+		"\"start\":",
+		"\"dev\":",
+		"\"serve\":",
+	}
+
+	nodeRunPatterns := []string{
+		"node ",
+		"ts-node",
+		"nodemon",
+		"tsx ",
+		"npx ts-node",
+	}
+
+	for _, startPat := range startPatterns {
+		if idx := strings.Index(content, startPat); idx != -1 {
+			// Get the script value (next ~100 chars should be enough)
+			end := idx + 150
+			if end > len(content) {
+				end = len(content)
+			}
+			scriptSection := content[idx:end]
+			for _, runPat := range nodeRunPatterns {
+				if strings.Contains(scriptSection, runPat) {
+					return true
+				}
+			}
+		}
+	}
+
+	// Check for "main" field pointing to a JS file (not just types)
+	if strings.Contains(content, "\"main\":") {
+		// Look for .js extension in main field
+		mainIdx := strings.Index(content, "\"main\":")
+		if mainIdx != -1 {
+			end := mainIdx + 80
+			if end > len(content) {
+				end = len(content)
+			}
+			mainSection := content[mainIdx:end]
+			if strings.Contains(mainSection, ".js\"") && !strings.Contains(mainSection, ".d.ts") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// packageDependencies returns the names of all dependencies and
+// devDependencies declared in the root package.json
+func packageDependencies(rootDir string) map[string]bool {
+	deps := make(map[string]bool)
+
+	data, err := os.ReadFile(filepath.Join(rootDir, "package.json"))
+	if err != nil {
+		return deps
+	}
+
+	var pkg struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return deps
+	}
+
+	for name := range pkg.Dependencies {
+		deps[name] = true
+	}
+	for name := range pkg.DevDependencies {
+		deps[name] = true
+	}
+	return deps
+}
+
+// hasGem checks if the Gemfile declares the given gem
+func hasGem(rootDir, gem string) bool {
+	data, err := os.ReadFile(filepath.Join(rootDir, "Gemfile"))
+	if err != nil {
+		return false
+	}
+	pattern := regexp.MustCompile(`(?m)^\s*gem\s+["']` + regexp.QuoteMeta(gem) + `["']`)
+	return pattern.Match(data)
+}
+
+// composerRequires checks if composer.json requires the given package
+func composerRequires(rootDir, pkg string) bool {
+	data, err := os.ReadFile(filepath.Join(rootDir, "composer.json"))
+	if err != nil {
+		return false
+	}
+
+	var composer struct {
+		Require map[string]string `json:"require"`
+	}
+	if err := json.Unmarshal(data, &composer); err != nil {
+		return false
+	}
+	_, ok := composer.Require[pkg]
+	return ok
+}
+
+func fileExists(rootDir, relativePath string) bool {
+	path := filepath.Join(rootDir, relativePath)
+	_, err := os.Stat(path)
+	return err == nil
+}